@@ -1,5 +1,13 @@
 data "prodata_images" "all" {}
 
+data "prodata_images" "ubuntu_custom" {
+  filter {
+    is_custom  = true
+    name_regex = "^ubuntu-"
+  }
+  most_recent = true
+}
+
 output "images" {
   value = data.prodata_images.all.images
 }
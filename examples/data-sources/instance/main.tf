@@ -0,0 +1,7 @@
+data "prodata_instance" "by_name" {
+  name = "web-1"
+}
+
+output "instance_status" {
+  value = data.prodata_instance.by_name.status
+}
@@ -0,0 +1,5 @@
+data "prodata_instances" "all" {}
+
+output "instances" {
+  value = data.prodata_instances.all.instances
+}
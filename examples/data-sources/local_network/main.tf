@@ -2,6 +2,14 @@ data "prodata_local_network" "example" {
   id = 12345
 }
 
+data "prodata_local_network" "shared" {
+  name = "shared-backend"
+}
+
+data "prodata_local_network" "by_cidr" {
+  cidr = "10.20.0.0/24"
+}
+
 output "network_cidr" {
   value = data.prodata_local_network.example.cidr
 }
@@ -1,5 +1,12 @@
 data "prodata_local_networks" "all" {}
 
+data "prodata_local_networks" "backend" {
+  filter {
+    name_regex = "^backend-"
+  }
+  sort_by = "created_at"
+}
+
 output "networks" {
   value = data.prodata_local_networks.all.local_networks
 }
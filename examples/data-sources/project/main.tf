@@ -0,0 +1,7 @@
+data "prodata_project" "production" {
+  name = "production"
+}
+
+output "production_project_id" {
+  value = data.prodata_project.production.id
+}
@@ -0,0 +1,12 @@
+data "prodata_projects" "all" {}
+
+data "prodata_projects" "teams" {
+  filter {
+    name_regex = "^team-"
+  }
+  sort_by = "name"
+}
+
+output "projects" {
+  value = data.prodata_projects.all.projects
+}
@@ -2,6 +2,14 @@ data "prodata_public_ip" "example" {
   id = 12345
 }
 
+data "prodata_public_ip" "ingress" {
+  name = "shared-ingress"
+}
+
+data "prodata_public_ip" "by_address" {
+  ip = "203.0.113.10"
+}
+
 output "ip_address" {
   value = data.prodata_public_ip.example.ip
 }
@@ -1,5 +1,12 @@
 data "prodata_public_ips" "all" {}
 
+data "prodata_public_ips" "free" {
+  filter {
+    attached = false
+  }
+  sort_by = "id"
+}
+
 output "public_ips" {
   value = data.prodata_public_ips.all.public_ips
 }
@@ -0,0 +1,9 @@
+data "prodata_regions" "all" {}
+
+output "region_ids" {
+  value = data.prodata_regions.all.regions[*].id
+}
+
+locals {
+  volume_regions = [for r in data.prodata_regions.all.regions : r.id if contains(r.services, "volumes")]
+}
@@ -0,0 +1,5 @@
+data "prodata_ssh_keys" "all" {}
+
+output "ssh_key_names" {
+  value = data.prodata_ssh_keys.all.ssh_keys[*].name
+}
@@ -2,6 +2,10 @@ data "prodata_volume" "example" {
   id = 12345
 }
 
+data "prodata_volume" "shared" {
+  name = "shared-backups"
+}
+
 output "volume_name" {
   value = data.prodata_volume.example.name
 }
@@ -0,0 +1,7 @@
+data "prodata_volume_snapshot" "example" {
+  id = 12345
+}
+
+output "snapshot_created_at" {
+  value = data.prodata_volume_snapshot.example.created_at
+}
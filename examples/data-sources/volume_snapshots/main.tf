@@ -0,0 +1,7 @@
+data "prodata_volume_snapshots" "example" {
+  volume_id = 12345
+}
+
+output "snapshot_ids" {
+  value = data.prodata_volume_snapshots.example.snapshots[*].id
+}
@@ -1,5 +1,21 @@
 data "prodata_volumes" "all" {}
 
+data "prodata_volumes" "free_ssd" {
+  filter {
+    type     = "SSD"
+    attached = false
+  }
+  sort_by = "name"
+}
+
+data "prodata_volumes" "storage_team" {
+  filter {
+    tags = {
+      cost_center = "storage"
+    }
+  }
+}
+
 output "volumes" {
   value = data.prodata_volumes.all.volumes
 }
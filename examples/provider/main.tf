@@ -13,6 +13,14 @@ provider "prodata" {
   api_secret_key = "sk_xxxxxxxxxxxxx"
   region         = "UZ-5"
   project_id     = 123
+
+  # Tags applied to every resource that supports tags
+  default_tags {
+    tags = {
+      environment = "production"
+      owner       = "platform-team"
+    }
+  }
 }
 
 # Alternatively, use environment variables:
@@ -20,4 +28,4 @@ provider "prodata" {
 # PRODATA_API_KEY_ID
 # PRODATA_API_SECRET_KEY
 # PRODATA_REGION
-# PRODATA_PROJECT_ID
+# PRODATA_PROJECT_ID (or PRODATA_PROJECT_NAME)
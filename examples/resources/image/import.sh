@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<id> ID.
+terraform import prodata_image.golden UZ-5/123/4567
+
+# Import using the bare ID with the provider's default region and project.
+terraform import prodata_image.golden 4567
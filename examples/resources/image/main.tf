@@ -0,0 +1,11 @@
+# Capture an instance's boot disk.
+resource "prodata_image" "golden" {
+  name        = "golden-web"
+  instance_id = prodata_instance.example.id
+}
+
+# Or create an image from a volume snapshot.
+resource "prodata_image" "from_snapshot" {
+  name        = "restored-image"
+  snapshot_id = prodata_volume_snapshot.example.id
+}
@@ -0,0 +1,38 @@
+data "prodata_image" "ubuntu" {
+  slug = "ubuntu-22.04"
+}
+
+resource "prodata_local_network" "example" {
+  name    = "my-network"
+  cidr    = "10.0.0.0/24"
+  gateway = "10.0.0.1"
+}
+
+resource "prodata_public_ip" "example" {
+  name = "my-public-ip"
+}
+
+resource "prodata_instance" "example" {
+  name      = "my-instance"
+  image_id  = data.prodata_image.ubuntu.id
+  cpu       = 2
+  ram       = 4
+  disk_size = 40
+  disk_type = "SSD"
+
+  ssh_keys = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGq3e6n0m2J6cI3b8tKkE8r0o0Y0k2n8Sx4m1nQm8V2b admin@example.com",
+  ]
+
+  local_network_ids = [prodata_local_network.example.id]
+  public_ip_ids     = [prodata_public_ip.example.id]
+
+  user_data = <<-EOT
+    #cloud-config
+    package_update: true
+  EOT
+
+  timeouts {
+    create = "30m"
+  }
+}
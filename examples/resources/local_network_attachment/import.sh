@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<local_network_id>/<instance_id> ID.
+terraform import prodata_local_network_attachment.web UZ-5/123/4567/890
+
+# Import using <local_network_id>/<instance_id> with the provider's default region and project.
+terraform import prodata_local_network_attachment.web 4567/890
@@ -0,0 +1,11 @@
+resource "prodata_local_network" "private" {
+  name    = "my-network"
+  cidr    = "10.0.0.0/24"
+  gateway = "10.0.0.1"
+}
+
+resource "prodata_local_network_attachment" "web" {
+  local_network_id = prodata_local_network.private.id
+  instance_id      = prodata_instance.example.id
+  private_ip       = "10.0.0.10"
+}
@@ -0,0 +1,5 @@
+# Import using the numeric ID.
+terraform import prodata_project.staging 123
+
+# Import using the project name.
+terraform import prodata_project.staging staging
@@ -0,0 +1,14 @@
+resource "prodata_project" "staging" {
+  name = "staging"
+
+  tags = {
+    environment = "staging"
+  }
+}
+
+resource "prodata_volume" "data" {
+  project_id = prodata_project.staging.id
+  name       = "staging-data"
+  type       = "HDD"
+  size       = 50
+}
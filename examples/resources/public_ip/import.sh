@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<id> ID.
+terraform import prodata_public_ip.example UZ-5/123/4567
+
+# Import using the bare ID with the provider's default region and project.
+terraform import prodata_public_ip.example 4567
@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<public_ip_id> ID.
+terraform import prodata_public_ip_association.web UZ-5/123/4567
+
+# Import using the bare public IP ID with the provider's default region and project.
+terraform import prodata_public_ip_association.web 4567
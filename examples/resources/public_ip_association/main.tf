@@ -0,0 +1,9 @@
+resource "prodata_public_ip" "web" {
+  name = "web"
+}
+
+# Change instance_id to move the address, e.g. from the blue to the green deployment.
+resource "prodata_public_ip_association" "web" {
+  public_ip_id = prodata_public_ip.web.id
+  instance_id  = prodata_instance.blue.id
+}
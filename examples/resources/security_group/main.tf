@@ -0,0 +1,4 @@
+resource "prodata_security_group" "web" {
+  name        = "web"
+  description = "Public HTTP(S) and SSH from the office"
+}
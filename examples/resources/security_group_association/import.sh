@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<security_group_id>/<instance_id> ID.
+terraform import prodata_security_group_association.web UZ-5/123/4567/890
+
+# Import using <security_group_id>/<instance_id> with the provider's default region and project.
+terraform import prodata_security_group_association.web 4567/890
@@ -0,0 +1,4 @@
+resource "prodata_security_group_association" "web" {
+  security_group_id = prodata_security_group.web.id
+  instance_id       = prodata_instance.example.id
+}
@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<security_group_id>/<rule_id> ID.
+terraform import prodata_security_group_rule.https UZ-5/123/4567/89
+
+# Import using <security_group_id>/<rule_id> with the provider's default region and project.
+terraform import prodata_security_group_rule.https 4567/89
@@ -0,0 +1,26 @@
+resource "prodata_security_group_rule" "https" {
+  security_group_id = prodata_security_group.web.id
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range_min    = 443
+  port_range_max    = 443
+  cidr              = "0.0.0.0/0"
+}
+
+resource "prodata_security_group_rule" "ssh" {
+  security_group_id = prodata_security_group.web.id
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  cidr              = "203.0.113.0/24"
+  description       = "Office"
+}
+
+# Allow all traffic from instances in the bastion group.
+resource "prodata_security_group_rule" "from_bastion" {
+  security_group_id        = prodata_security_group.web.id
+  direction                = "ingress"
+  protocol                 = "any"
+  source_security_group_id = prodata_security_group.bastion.id
+}
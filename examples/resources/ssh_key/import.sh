@@ -0,0 +1,5 @@
+# Import using the numeric ID.
+terraform import prodata_ssh_key.admin 4567
+
+# Import using the key fingerprint.
+terraform import prodata_ssh_key.admin SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8
@@ -0,0 +1,4 @@
+resource "prodata_ssh_key" "admin" {
+  name       = "admin"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
@@ -2,4 +2,8 @@ resource "prodata_volume" "example" {
   name = "my-volume"
   type = "HDD"
   size = 10
+
+  tags = {
+    cost_center = "storage"
+  }
 }
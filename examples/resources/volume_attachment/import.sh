@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<volume_id>/<instance_id> ID.
+terraform import prodata_volume_attachment.data UZ-5/123/4567/890
+
+# Import using <volume_id>/<instance_id> with the provider's default region and project.
+terraform import prodata_volume_attachment.data 4567/890
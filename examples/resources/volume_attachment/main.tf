@@ -0,0 +1,10 @@
+resource "prodata_volume" "data" {
+  name = "my-data-volume"
+  type = "SSD"
+  size = 50
+}
+
+resource "prodata_volume_attachment" "data" {
+  volume_id   = prodata_volume.data.id
+  instance_id = prodata_instance.example.id
+}
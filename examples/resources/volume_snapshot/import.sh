@@ -0,0 +1,5 @@
+# Import using a composite <region>/<project_id>/<id> ID.
+terraform import prodata_volume_snapshot.example UZ-5/123/4567
+
+# Import using the bare ID with the provider's default region and project.
+terraform import prodata_volume_snapshot.example 4567
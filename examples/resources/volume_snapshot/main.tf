@@ -0,0 +1,10 @@
+resource "prodata_volume" "example" {
+  name = "my-volume"
+  type = "HDD"
+  size = 10
+}
+
+resource "prodata_volume_snapshot" "example" {
+  volume_id = prodata_volume.example.id
+  name      = "my-volume-backup"
+}
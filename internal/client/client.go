@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
@@ -21,7 +23,17 @@ type Client struct {
 	userAgent    string
 	Region       string
 	ProjectID    int64
+	// DefaultTags are merged into the tags of every object the provider creates
+	// or updates. Tags set on the object take precedence.
+	DefaultTags  map[string]string
 	httpClient   *http.Client
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	limiter      *rateLimiter
+
+	volumeTypesMu sync.Mutex
+	volumeTypes   map[string]volumeTypesEntry
 }
 
 type Config struct {
@@ -31,12 +43,41 @@ type Config struct {
 	UserAgent    string
 	Region       string
 	ProjectID    int64
+	DefaultTags  map[string]string
+
+	// MaxRetries is the number of times a failed request is retried. Zero disables retries.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries.
+	// Zero values fall back to DefaultRetryWaitMin and DefaultRetryWaitMax.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// RequestsPerSecond limits the rate of requests sent by the client. Zero disables the limit.
+	// Burst is the number of requests that may be sent at once; zero derives it from RequestsPerSecond.
+	RequestsPerSecond float64
+	Burst             int
 }
 
 func New(cfg Config) (*Client, error) {
 	if cfg.APIBaseURL == "" || cfg.APIKeyID == "" || cfg.APISecretKey == "" {
 		return nil, fmt.Errorf("api_base_url, api_key_id, and api_secret_key are required")
 	}
+	if cfg.MaxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must not be negative")
+	}
+	if cfg.RequestsPerSecond < 0 || cfg.Burst < 0 {
+		return nil, fmt.Errorf("requests_per_second and burst must not be negative")
+	}
+
+	if cfg.RetryWaitMin == 0 {
+		cfg.RetryWaitMin = DefaultRetryWaitMin
+	}
+	if cfg.RetryWaitMax == 0 {
+		cfg.RetryWaitMax = DefaultRetryWaitMax
+	}
+	if cfg.RetryWaitMin > cfg.RetryWaitMax {
+		return nil, fmt.Errorf("retry_wait_min (%s) must not exceed retry_wait_max (%s)", cfg.RetryWaitMin, cfg.RetryWaitMax)
+	}
 
 	return &Client{
 		baseURL:      strings.TrimRight(cfg.APIBaseURL, "/") + "/panel-main",
@@ -45,19 +86,20 @@ func New(cfg Config) (*Client, error) {
 		userAgent:    cfg.UserAgent,
 		Region:       cfg.Region,
 		ProjectID:    cfg.ProjectID,
+		DefaultTags:  cfg.DefaultTags,
 		httpClient:   &http.Client{Timeout: 30 * time.Second},
+		maxRetries:   cfg.MaxRetries,
+		retryWaitMin: cfg.RetryWaitMin,
+		retryWaitMax: cfg.RetryWaitMax,
+		limiter:      newRateLimiter(cfg.RequestsPerSecond, cfg.Burst),
 	}, nil
 }
 
 type apiResponse[T any] struct {
-	Success bool       `json:"success"`
-	Data    T          `json:"data"`
-	Errors  []apiError `json:"errors"`
-}
-
-type apiError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Success bool             `json:"success"`
+	Data    T                `json:"data"`
+	Errors  []APIErrorDetail `json:"errors"`
+	Meta    *pageMeta        `json:"meta"`
 }
 
 // RequestOpts allows per-request overrides of region and project.
@@ -66,29 +108,50 @@ type RequestOpts struct {
 	ProjectID int64
 }
 
+// regionalQuery encodes the region and project overrides from opts as query
+// parameters for DELETE requests, which carry no body.
+func regionalQuery(opts *RequestOpts) string {
+	// Only add query params if explicitly provided in opts (overrides provider defaults)
+	if opts == nil || (opts.Region == "" && opts.ProjectID == 0) {
+		return ""
+	}
+
+	params := url.Values{}
+	if opts.Region != "" {
+		params.Set("region", opts.Region)
+	}
+	if opts.ProjectID != 0 {
+		params.Set("projectId", strconv.FormatInt(opts.ProjectID, 10))
+	}
+	return "?" + params.Encode()
+}
+
 func (c *Client) Do(ctx context.Context, method, path string, body, result any, opts *RequestOpts) error {
-	var reqBody io.Reader
+	_, err := c.do(ctx, method, path, body, result, opts)
+	return err
+}
+
+// do performs the request like Do and also returns the pagination metadata of the
+// response, which is nil when the endpoint does not paginate.
+func (c *Client) do(ctx context.Context, method, path string, body, result any, opts *RequestOpts) (*pageMeta, error) {
+	ctx = c.logContext(ctx)
+
 	var reqBodyBytes []byte
 
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
-			log.Printf("[ERROR] Failed to marshal request body: %v", err)
-			log.Printf("[ERROR] Request: %s %s", method, path)
-			log.Printf("[ERROR] Body: %+v", body)
-			return fmt.Errorf("marshal request: %w", err)
+			tflog.SubsystemError(ctx, logSubsystem, "Failed to marshal request body", map[string]any{
+				"method": method,
+				"path":   path,
+				"error":  err.Error(),
+			})
+			return nil, fmt.Errorf("marshal request: %w", err)
 		}
 		reqBodyBytes = b
-		reqBody = bytes.NewReader(b)
 	}
 
 	fullURL := c.baseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
-	if err != nil {
-		log.Printf("[ERROR] Failed to create HTTP request: %v", err)
-		log.Printf("[ERROR] Request: %s %s", method, fullURL)
-		return fmt.Errorf("create request: %w", err)
-	}
 
 	// Determine region and project: use per-request opts if provided, else client defaults.
 	region := c.Region
@@ -102,6 +165,96 @@ func (c *Client) Do(ctx context.Context, method, path string, body, result any,
 		}
 	}
 
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "method", method)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "path", path)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "region", region)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "project_id", projectID)
+
+	var resp *http.Response
+	var respBody []byte
+	for attempt := 0; ; attempt++ {
+		var err error
+		resp, respBody, err = c.send(ctx, method, fullURL, reqBodyBytes, region, projectID)
+
+		if attempt < c.maxRetries && shouldRetry(ctx, method, resp, err) {
+			wait := c.backoff(attempt, resp)
+			fields := map[string]any{
+				"attempt":     attempt + 1,
+				"max_retries": c.maxRetries,
+				"wait":        wait.String(),
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			} else {
+				fields["status"] = resp.StatusCode
+			}
+			tflog.SubsystemWarn(ctx, logSubsystem, "Retrying API request", fields)
+			if err := sleep(ctx, wait); err != nil {
+				return nil, fmt.Errorf("request failed: %w", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			tflog.SubsystemError(ctx, logSubsystem, "API request failed", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, err
+		}
+		break
+	}
+
+	var apiResp apiResponse[json.RawMessage]
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		tflog.SubsystemError(ctx, logSubsystem, "Failed to parse API response", map[string]any{
+			"status": resp.StatusCode,
+			"error":  err.Error(),
+		})
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, newAPIError(method, path, resp, nil)
+		}
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+
+	if !apiResp.Success {
+		apiErr := newAPIError(method, path, resp, apiResp.Errors)
+		tflog.SubsystemError(ctx, logSubsystem, "API returned error response", map[string]any{
+			"status":     resp.StatusCode,
+			"request_id": apiErr.RequestID,
+			"errors":     formatAPIErrors(apiResp.Errors),
+		})
+		return nil, apiErr
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(apiResp.Data, result); err != nil {
+			tflog.SubsystemError(ctx, logSubsystem, "Failed to parse API response data", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, fmt.Errorf("parse data: %w", err)
+		}
+	}
+
+	return apiResp.Meta, nil
+}
+
+// send performs a single HTTP round trip, subject to the client's rate limit, and
+// reads the full response body. The body is rebuilt from reqBodyBytes so the request can be retried.
+func (c *Client) send(ctx context.Context, method, fullURL string, reqBodyBytes []byte, region string, projectID int64) (*http.Response, []byte, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	var reqBody io.Reader
+	if reqBodyBytes != nil {
+		reqBody = bytes.NewReader(reqBodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create request: %w", err)
+	}
+
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("X-Api-Key-Id", c.apiKeyID)
@@ -109,86 +262,49 @@ func (c *Client) Do(ctx context.Context, method, path string, body, result any,
 	req.Header.Set("X-Region", region)
 	req.Header.Set("X-Project-Id", strconv.FormatInt(projectID, 10))
 
-	log.Printf("[DEBUG] API Request: %s %s", method, fullURL)
-	log.Printf("[DEBUG] Headers: X-Region=%s, X-Project-Id=%d", region, projectID)
-	if len(reqBodyBytes) > 0 {
-		log.Printf("[DEBUG] Request Body: %s", string(reqBodyBytes))
-	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Sending API request")
+	logWireRequest(ctx, req, reqBodyBytes)
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		log.Printf("[ERROR] HTTP request failed: %v", err)
-		log.Printf("[ERROR] Request: %s %s", method, fullURL)
-		log.Printf("[ERROR] Headers: X-Region=%s, X-Project-Id=%d", region, projectID)
-		if len(reqBodyBytes) > 0 {
-			log.Printf("[ERROR] Request Body: %s", string(reqBodyBytes))
-		}
-		return fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	log.Printf("[DEBUG] Response Status: %d %s", resp.StatusCode, resp.Status)
-
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		log.Printf("[ERROR] Failed to read response body: %v", err)
-		log.Printf("[ERROR] Request: %s %s", method, fullURL)
-		log.Printf("[ERROR] Response Status: %d", resp.StatusCode)
-		return fmt.Errorf("read response: %w", err)
-	}
-
-	log.Printf("[DEBUG] Response Body: %s", string(respBody))
-
-	var apiResp apiResponse[json.RawMessage]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		log.Printf("[ERROR] Failed to parse response JSON: %v", err)
-		log.Printf("[ERROR] Request: %s %s", method, fullURL)
-		log.Printf("[ERROR] Response Status: %d", resp.StatusCode)
-		log.Printf("[ERROR] Response Body: %s", string(respBody))
-		return fmt.Errorf("parse response: %w", err)
+		return resp, nil, fmt.Errorf("read response: %w", err)
 	}
 
-	if !apiResp.Success {
-		log.Printf("[ERROR] API returned error response")
-		log.Printf("[ERROR] Request: %s %s", method, fullURL)
-		log.Printf("[ERROR] Headers: X-Region=%s, X-Project-Id=%d", region, projectID)
-		if len(reqBodyBytes) > 0 {
-			log.Printf("[ERROR] Request Body: %s", string(reqBodyBytes))
-		}
-		log.Printf("[ERROR] Response Status: %d", resp.StatusCode)
-		log.Printf("[ERROR] Response Body: %s", string(respBody))
-		log.Printf("[ERROR] API Errors: %s", formatAPIErrors(apiResp.Errors))
-		return fmt.Errorf("api error: %s", formatAPIErrors(apiResp.Errors))
-	}
-
-	if result != nil {
-		if err := json.Unmarshal(apiResp.Data, result); err != nil {
-			log.Printf("[ERROR] Failed to parse response data: %v", err)
-			log.Printf("[ERROR] Request: %s %s", method, fullURL)
-			log.Printf("[ERROR] Response Data: %s", string(apiResp.Data))
-			return fmt.Errorf("parse data: %w", err)
-		}
-	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received API response", map[string]any{
+		"status":      resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"request_id":  resp.Header.Get("X-Request-Id"),
+	})
+	logWireResponse(ctx, resp, respBody)
 
-	return nil
+	return resp, respBody, nil
 }
 
-func formatAPIErrors(errs []apiError) string {
-	if len(errs) == 0 {
-		return "unknown error"
-	}
-	msgs := make([]string, len(errs))
-	for i, e := range errs {
-		msgs[i] = fmt.Sprintf("[%d] %s", e.Code, e.Message)
+func newAPIError(method, path string, resp *http.Response, errs []APIErrorDetail) *APIError {
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Errors:     errs,
 	}
-	return strings.Join(msgs, "; ")
 }
 
 type Image struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	Slug     string `json:"slug"`
-	IsCustom bool   `json:"isCustom"`
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	Slug      string            `json:"slug"`
+	IsCustom  bool              `json:"isCustom"`
+	Status    string            `json:"status"`
+	CreatedAt string            `json:"createdAt"`
+	Tags      map[string]string `json:"tags"`
 }
 
 type ImageQuery struct {
@@ -221,29 +337,24 @@ func (c *Client) GetImage(ctx context.Context, q ImageQuery) (*Image, error) {
 	return &img, nil
 }
 
-func (c *Client) GetImages(ctx context.Context, opts *RequestOpts) ([]Image, error) {
-	var images []Image
-	if err := c.Do(ctx, http.MethodGet, "/api/v2/images", nil, &images, opts); err != nil {
-		return nil, err
-	}
-	return images, nil
+func (c *Client) GetImages(ctx context.Context, filter *ListFilter, opts *RequestOpts) ([]Image, error) {
+	return listAll[Image](ctx, c, filter.path("/api/v2/images"), opts)
 }
 
 type Volume struct {
-	ID         int64  `json:"id"`
-	Name       string `json:"name"`
-	Type       string `json:"type"`
-	Size       int64  `json:"size"`
-	InUse      bool   `json:"inUse"`
-	AttachedID *int64 `json:"attachedId"`
+	ID         int64             `json:"id"`
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	Size       int64             `json:"size"`
+	InUse      bool              `json:"inUse"`
+	AttachedID *int64            `json:"attachedId"`
+	Status     string            `json:"status"`
+	CreatedAt  string            `json:"createdAt"`
+	Tags       map[string]string `json:"tags"`
 }
 
-func (c *Client) GetVolumes(ctx context.Context, opts *RequestOpts) ([]Volume, error) {
-	var volumes []Volume
-	if err := c.Do(ctx, http.MethodGet, "/api/v2/volumes", nil, &volumes, opts); err != nil {
-		return nil, err
-	}
-	return volumes, nil
+func (c *Client) GetVolumes(ctx context.Context, filter *ListFilter, opts *RequestOpts) ([]Volume, error) {
+	return listAll[Volume](ctx, c, filter.path("/api/v2/volumes"), opts)
 }
 
 func (c *Client) GetVolume(ctx context.Context, id int64, opts *RequestOpts) (*Volume, error) {
@@ -255,12 +366,40 @@ func (c *Client) GetVolume(ctx context.Context, id int64, opts *RequestOpts) (*V
 	return &volume, nil
 }
 
+// WaitForVolumeReady polls GetVolume until the volume reaches a ready status.
+func (c *Client) WaitForVolumeReady(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) (*Volume, error) {
+	get := func(ctx context.Context) (*Volume, error) { return c.GetVolume(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(v *Volume) string { return v.Status })
+}
+
+// WaitForVolumeResized polls GetVolume until the volume reports the given size and
+// has settled into a ready status. A resize the API has not picked up yet is
+// treated as pending.
+func (c *Client) WaitForVolumeResized(ctx context.Context, id, size int64, opts *RequestOpts, timeout time.Duration) (*Volume, error) {
+	get := func(ctx context.Context) (*Volume, error) { return c.GetVolume(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(v *Volume) string {
+		if v.Size != size && containsStatus(readyStatuses, v.Status) {
+			return "resizing"
+		}
+		return v.Status
+	})
+}
+
+// WaitForVolumeDeleted polls GetVolume until the volume no longer exists.
+func (c *Client) WaitForVolumeDeleted(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) error {
+	get := func(ctx context.Context) (*Volume, error) { return c.GetVolume(ctx, id, opts) }
+	return waitForDeleted(ctx, timeout, get, func(v *Volume) string { return v.Status })
+}
+
 type CreateVolumeRequest struct {
 	Region    string `json:"region"`
 	ProjectID int64  `json:"projectId"`
 	Name      string `json:"name"`
 	Type      string `json:"type"`
 	Size      int64  `json:"size"`
+	// SnapshotID restores the volume from a snapshot when set.
+	SnapshotID int64             `json:"snapshotId,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
 }
 
 func (c *Client) CreateVolume(ctx context.Context, req CreateVolumeRequest) (*Volume, error) {
@@ -282,6 +421,10 @@ type UpdateVolumeRequest struct {
 	Region    string `json:"region,omitempty"`
 	ProjectID int64  `json:"projectId,omitempty"`
 	Name      string `json:"name"`
+	// Size grows the volume when set. Volumes cannot be shrunk.
+	Size int64 `json:"size,omitempty"`
+	// Tags replaces the tags of the volume. A nil map is sent as null and leaves them unchanged.
+	Tags map[string]string `json:"tags"`
 }
 
 func (c *Client) UpdateVolume(ctx context.Context, id int64, req UpdateVolumeRequest) (*Volume, error) {
@@ -301,49 +444,90 @@ func (c *Client) UpdateVolume(ctx context.Context, id int64, req UpdateVolumeReq
 }
 
 func (c *Client) DeleteVolume(ctx context.Context, id int64, opts *RequestOpts) error {
-	path := fmt.Sprintf("/api/v2/volumes/%d", id)
+	path := fmt.Sprintf("/api/v2/volumes/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// Only add query params if explicitly provided in opts (overrides provider defaults)
-	if opts != nil && (opts.Region != "" || opts.ProjectID != 0) {
-		params := url.Values{}
-		if opts.Region != "" {
-			params.Set("region", opts.Region)
-		}
-		if opts.ProjectID != 0 {
-			params.Set("projectId", strconv.FormatInt(opts.ProjectID, 10))
-		}
-		path = path + "?" + params.Encode()
+type AttachVolumeRequest struct {
+	Region     string `json:"region,omitempty"`
+	ProjectID  int64  `json:"projectId,omitempty"`
+	InstanceID int64  `json:"instanceId"`
+}
+
+// AttachVolume attaches a volume to an instance. The API rejects the request with a
+// conflict when the volume is already attached elsewhere.
+func (c *Client) AttachVolume(ctx context.Context, id int64, req AttachVolumeRequest) (*Volume, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
 	}
 
-	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+	path := fmt.Sprintf("/api/v2/volumes/%d/attach", id)
+	var volume Volume
+	if err := c.Do(ctx, http.MethodPost, path, req, &volume, nil); err != nil {
+		return nil, err
+	}
+	return &volume, nil
+}
+
+type DetachVolumeRequest struct {
+	Region    string `json:"region,omitempty"`
+	ProjectID int64  `json:"projectId,omitempty"`
+}
+
+// DetachVolume detaches a volume from the instance it is attached to.
+func (c *Client) DetachVolume(ctx context.Context, id int64, req DetachVolumeRequest) error {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/volumes/%d/detach", id)
+	if err := c.Do(ctx, http.MethodPost, path, req, nil, nil); err != nil {
 		return err
 	}
 	return nil
 }
 
+// WaitForVolumeAttachment polls GetVolume until the volume is attached to instanceID,
+// or detached from any instance when instanceID is zero.
+func (c *Client) WaitForVolumeAttachment(ctx context.Context, id, instanceID int64, opts *RequestOpts, timeout time.Duration) (*Volume, error) {
+	get := func(ctx context.Context) (*Volume, error) { return c.GetVolume(ctx, id, opts) }
+	return waitForAttachment(ctx, timeout, instanceID, get,
+		func(v *Volume) string { return v.Status },
+		func(v *Volume) *int64 { return v.AttachedID })
+}
+
 // LocalNetwork represents a local network resource.
 type LocalNetwork struct {
-	ID      int64  `json:"id"`
-	Name    string `json:"name"`
-	CIDR    string `json:"cidr"`
-	Gateway string `json:"gateway"`
-	Linked  bool   `json:"linked"`
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	CIDR      string            `json:"cidr"`
+	Gateway   string            `json:"gateway"`
+	Linked    bool              `json:"linked"`
+	Status    string            `json:"status"`
+	CreatedAt string            `json:"createdAt"`
+	Tags      map[string]string `json:"tags"`
 }
 
-func (c *Client) GetLocalNetworks(ctx context.Context, opts *RequestOpts) ([]LocalNetwork, error) {
-	var networks []LocalNetwork
-	if err := c.Do(ctx, http.MethodGet, "/api/v2/local-networks", nil, &networks, opts); err != nil {
-		return nil, err
-	}
-	return networks, nil
+func (c *Client) GetLocalNetworks(ctx context.Context, filter *ListFilter, opts *RequestOpts) ([]LocalNetwork, error) {
+	return listAll[LocalNetwork](ctx, c, filter.path("/api/v2/local-networks"), opts)
 }
 
 type CreateLocalNetworkRequest struct {
-	Region    string `json:"region"`
-	ProjectID int64  `json:"projectId"`
-	Name      string `json:"name"`
-	CIDR      string `json:"cidr"`
-	Gateway   string `json:"gateway"`
+	Region    string            `json:"region"`
+	ProjectID int64             `json:"projectId"`
+	Name      string            `json:"name"`
+	CIDR      string            `json:"cidr"`
+	Gateway   string            `json:"gateway"`
+	Tags      map[string]string `json:"tags,omitempty"`
 }
 
 func (c *Client) CreateLocalNetwork(ctx context.Context, req CreateLocalNetworkRequest) (*LocalNetwork, error) {
@@ -370,10 +554,23 @@ func (c *Client) GetLocalNetwork(ctx context.Context, id int64, opts *RequestOpt
 	return &network, nil
 }
 
+// WaitForLocalNetworkReady polls GetLocalNetwork until the network reaches a ready status.
+func (c *Client) WaitForLocalNetworkReady(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) (*LocalNetwork, error) {
+	get := func(ctx context.Context) (*LocalNetwork, error) { return c.GetLocalNetwork(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(n *LocalNetwork) string { return n.Status })
+}
+
+// WaitForLocalNetworkDeleted polls GetLocalNetwork until the network no longer exists.
+func (c *Client) WaitForLocalNetworkDeleted(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) error {
+	get := func(ctx context.Context) (*LocalNetwork, error) { return c.GetLocalNetwork(ctx, id, opts) }
+	return waitForDeleted(ctx, timeout, get, func(n *LocalNetwork) string { return n.Status })
+}
+
 type UpdateLocalNetworkRequest struct {
-	Region    string `json:"region,omitempty"`
-	ProjectID int64  `json:"projectId,omitempty"`
-	Name      string `json:"name"`
+	Region    string            `json:"region,omitempty"`
+	ProjectID int64             `json:"projectId,omitempty"`
+	Name      string            `json:"name"`
+	Tags      map[string]string `json:"tags"`
 }
 
 func (c *Client) UpdateLocalNetwork(ctx context.Context, id int64, req UpdateLocalNetworkRequest) (*LocalNetwork, error) {
@@ -393,26 +590,95 @@ func (c *Client) UpdateLocalNetwork(ctx context.Context, id int64, req UpdateLoc
 }
 
 func (c *Client) DeleteLocalNetwork(ctx context.Context, id int64, opts *RequestOpts) error {
-	path := fmt.Sprintf("/api/v2/local-networks/%d", id)
+	path := fmt.Sprintf("/api/v2/local-networks/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// Only add query params if explicitly provided in opts (overrides provider defaults)
-	if opts != nil && (opts.Region != "" || opts.ProjectID != 0) {
-		params := url.Values{}
-		if opts.Region != "" {
-			params.Set("region", opts.Region)
-		}
-		if opts.ProjectID != 0 {
-			params.Set("projectId", strconv.FormatInt(opts.ProjectID, 10))
-		}
-		path = path + "?" + params.Encode()
+type AttachLocalNetworkRequest struct {
+	Region     string `json:"region"`
+	ProjectID  int64  `json:"projectId,omitempty"`
+	InstanceID int64  `json:"instanceId"`
+	// IP requests a fixed private address; the API assigns one when empty.
+	IP string `json:"ip,omitempty"`
+}
+
+// AttachLocalNetwork connects an instance to a local network by adding a network interface.
+func (c *Client) AttachLocalNetwork(ctx context.Context, id int64, req AttachLocalNetworkRequest) error {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
 	}
 
-	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+	path := fmt.Sprintf("/api/v2/local-networks/%d/attach", id)
+	if err := c.Do(ctx, http.MethodPost, path, req, nil, nil); err != nil {
 		return err
 	}
 	return nil
 }
 
+type DetachLocalNetworkRequest struct {
+	Region     string `json:"region,omitempty"`
+	ProjectID  int64  `json:"projectId,omitempty"`
+	InstanceID int64  `json:"instanceId"`
+}
+
+// DetachLocalNetwork removes the instance's network interface on a local network.
+func (c *Client) DetachLocalNetwork(ctx context.Context, id int64, req DetachLocalNetworkRequest) error {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/local-networks/%d/detach", id)
+	if err := c.Do(ctx, http.MethodPost, path, req, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WaitForLocalNetworkAttachment polls GetInstance until the instance has a network
+// interface on the local network, or none when attached is false. It returns the
+// interface once attached.
+func (c *Client) WaitForLocalNetworkAttachment(ctx context.Context, id, instanceID int64, attached bool, opts *RequestOpts, timeout time.Duration) (*NetworkInterface, error) {
+	target := "attached"
+	if !attached {
+		target = "detached"
+	}
+
+	conf := &StateChangeConf{
+		Target:  []string{target},
+		Failed:  failedStatuses,
+		Timeout: timeout,
+		Refresh: func(ctx context.Context) (any, string, error) {
+			instance, err := c.GetInstance(ctx, instanceID, opts)
+			if err != nil {
+				return nil, "", err
+			}
+			if containsStatus(pendingStatuses, instance.Status) || containsStatus(failedStatuses, instance.Status) {
+				return instance, instance.Status, nil
+			}
+			if nic := instance.NetworkInterface(id); nic != nil {
+				return nic, "attached", nil
+			}
+			return instance, "detached", nil
+		},
+	}
+
+	result, err := conf.WaitForState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nic, _ := result.(*NetworkInterface)
+	return nic, nil
+}
+
 // PublicIP represents a public IP resource.
 type PublicIP struct {
 	ID      int64  `json:"id"`
@@ -420,14 +686,15 @@ type PublicIP struct {
 	IP      string `json:"ip"`
 	Mask    string `json:"mask"`
 	Gateway string `json:"gateway"`
+	Status  string `json:"status"`
+	// AttachedInstanceID is the instance the address is bound to, or nil if it is free.
+	AttachedInstanceID *int64            `json:"attachedInstanceId"`
+	CreatedAt          string            `json:"createdAt"`
+	Tags               map[string]string `json:"tags"`
 }
 
-func (c *Client) GetPublicIPs(ctx context.Context, opts *RequestOpts) ([]PublicIP, error) {
-	var ips []PublicIP
-	if err := c.Do(ctx, http.MethodGet, "/api/v2/public-ips", nil, &ips, opts); err != nil {
-		return nil, err
-	}
-	return ips, nil
+func (c *Client) GetPublicIPs(ctx context.Context, filter *ListFilter, opts *RequestOpts) ([]PublicIP, error) {
+	return listAll[PublicIP](ctx, c, filter.path("/api/v2/public-ips"), opts)
 }
 
 func (c *Client) GetPublicIP(ctx context.Context, id int64, opts *RequestOpts) (*PublicIP, error) {
@@ -439,10 +706,23 @@ func (c *Client) GetPublicIP(ctx context.Context, id int64, opts *RequestOpts) (
 	return &ip, nil
 }
 
+// WaitForPublicIPReady polls GetPublicIP until the address reaches a ready status.
+func (c *Client) WaitForPublicIPReady(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) (*PublicIP, error) {
+	get := func(ctx context.Context) (*PublicIP, error) { return c.GetPublicIP(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(ip *PublicIP) string { return ip.Status })
+}
+
+// WaitForPublicIPDeleted polls GetPublicIP until the address no longer exists.
+func (c *Client) WaitForPublicIPDeleted(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) error {
+	get := func(ctx context.Context) (*PublicIP, error) { return c.GetPublicIP(ctx, id, opts) }
+	return waitForDeleted(ctx, timeout, get, func(ip *PublicIP) string { return ip.Status })
+}
+
 type CreatePublicIPRequest struct {
-	Region    string `json:"region"`
-	ProjectID int64  `json:"projectId"`
-	Name      string `json:"name"`
+	Region    string            `json:"region"`
+	ProjectID int64             `json:"projectId"`
+	Name      string            `json:"name"`
+	Tags      map[string]string `json:"tags,omitempty"`
 }
 
 func (c *Client) CreatePublicIP(ctx context.Context, req CreatePublicIPRequest) (*PublicIP, error) {
@@ -461,9 +741,10 @@ func (c *Client) CreatePublicIP(ctx context.Context, req CreatePublicIPRequest)
 }
 
 type UpdatePublicIPRequest struct {
-	Region    string `json:"region,omitempty"`
-	ProjectID int64  `json:"projectId,omitempty"`
-	Name      string `json:"name"`
+	Region    string            `json:"region,omitempty"`
+	ProjectID int64             `json:"projectId,omitempty"`
+	Name      string            `json:"name"`
+	Tags      map[string]string `json:"tags"`
 }
 
 func (c *Client) UpdatePublicIP(ctx context.Context, id int64, req UpdatePublicIPRequest) (*PublicIP, error) {
@@ -483,23 +764,63 @@ func (c *Client) UpdatePublicIP(ctx context.Context, id int64, req UpdatePublicI
 }
 
 func (c *Client) DeletePublicIP(ctx context.Context, id int64, opts *RequestOpts) error {
-	path := fmt.Sprintf("/api/v2/public-ips/%d", id)
+	path := fmt.Sprintf("/api/v2/public-ips/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
 
-	// Only add query params if explicitly provided in opts (overrides provider defaults)
-	if opts != nil && (opts.Region != "" || opts.ProjectID != 0) {
-		params := url.Values{}
-		if opts.Region != "" {
-			params.Set("region", opts.Region)
-		}
-		if opts.ProjectID != 0 {
-			params.Set("projectId", strconv.FormatInt(opts.ProjectID, 10))
-		}
-		path = path + "?" + params.Encode()
+type AttachPublicIPRequest struct {
+	Region     string `json:"region"`
+	ProjectID  int64  `json:"projectId,omitempty"`
+	InstanceID int64  `json:"instanceId"`
+}
+
+// AttachPublicIP binds a public IP to an instance. The API responds with a
+// conflict when the address is already bound to another instance.
+func (c *Client) AttachPublicIP(ctx context.Context, id int64, req AttachPublicIPRequest) (*PublicIP, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
 	}
 
-	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+	path := fmt.Sprintf("/api/v2/public-ips/%d/attach", id)
+	var ip PublicIP
+	if err := c.Do(ctx, http.MethodPost, path, req, &ip, nil); err != nil {
+		return nil, err
+	}
+	return &ip, nil
+}
+
+type DetachPublicIPRequest struct {
+	Region    string `json:"region,omitempty"`
+	ProjectID int64  `json:"projectId,omitempty"`
+}
+
+// DetachPublicIP unbinds a public IP from the instance it is attached to.
+func (c *Client) DetachPublicIP(ctx context.Context, id int64, req DetachPublicIPRequest) error {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/public-ips/%d/detach", id)
+	if err := c.Do(ctx, http.MethodPost, path, req, nil, nil); err != nil {
 		return err
 	}
 	return nil
 }
 
+// WaitForPublicIPAttachment polls GetPublicIP until the address is bound to instanceID,
+// or unbound from any instance when instanceID is zero.
+func (c *Client) WaitForPublicIPAttachment(ctx context.Context, id, instanceID int64, opts *RequestOpts, timeout time.Duration) (*PublicIP, error) {
+	get := func(ctx context.Context) (*PublicIP, error) { return c.GetPublicIP(ctx, id, opts) }
+	return waitForAttachment(ctx, timeout, instanceID, get,
+		func(ip *PublicIP) string { return ip.Status },
+		func(ip *PublicIP) *int64 { return ip.AttachedInstanceID })
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by APIError.Is, for use with errors.Is.
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrConflict      = errors.New("conflict")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	ErrUnauthorized  = errors.New("unauthorized")
+)
+
+// APIErrorDetail is a single entry of the `errors` list returned by the API.
+type APIErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// APIError is returned by Client.Do when the API responds with a failure.
+// Use errors.As to inspect it, or the Is* helpers to classify it.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	RequestID  string
+	Errors     []APIErrorDetail
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("api error: %s %s returned %d: %s", e.Method, e.Path, e.StatusCode, e.message())
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+	return msg
+}
+
+// Is maps the HTTP status and API error codes onto the sentinel errors. Error
+// messages are not inspected, since their wording is not part of the API.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.hasCode(http.StatusNotFound)
+	case ErrConflict:
+		return e.hasCode(http.StatusConflict)
+	case ErrQuotaExceeded:
+		return e.hasCode(http.StatusPaymentRequired)
+	case ErrUnauthorized:
+		return e.hasCode(http.StatusUnauthorized) || e.hasCode(http.StatusForbidden)
+	}
+	return false
+}
+
+func (e *APIError) message() string {
+	if len(e.Errors) == 0 {
+		if text := http.StatusText(e.StatusCode); text != "" {
+			return text
+		}
+	}
+	return formatAPIErrors(e.Errors)
+}
+
+func (e *APIError) hasCode(code int) bool {
+	if e.StatusCode == code {
+		return true
+	}
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err indicates that the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err indicates a conflict with the current state of the object.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsQuotaExceeded reports whether err indicates that a project quota has been exhausted.
+func IsQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
+
+// IsUnauthorized reports whether err indicates that the credentials were rejected.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+func formatAPIErrors(errs []APIErrorDetail) string {
+	if len(errs) == 0 {
+		return "unknown error"
+	}
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = fmt.Sprintf("[%d] %s", e.Code, e.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *APIError
+		target error
+		want   bool
+	}{
+		{name: "404 is not found", err: &APIError{StatusCode: http.StatusNotFound}, target: ErrNotFound, want: true},
+		{name: "error code 404 is not found", err: &APIError{StatusCode: http.StatusBadRequest, Errors: []APIErrorDetail{{Code: 404, Message: "volume not found"}}}, target: ErrNotFound, want: true},
+		{name: "409 is a conflict", err: &APIError{StatusCode: http.StatusConflict}, target: ErrConflict, want: true},
+		{name: "402 is quota exceeded", err: &APIError{StatusCode: http.StatusPaymentRequired}, target: ErrQuotaExceeded, want: true},
+		{name: "error code 402 is quota exceeded", err: &APIError{StatusCode: http.StatusBadRequest, Errors: []APIErrorDetail{{Code: 402, Message: "limit reached"}}}, target: ErrQuotaExceeded, want: true},
+		{name: "quota in the message only", err: &APIError{StatusCode: http.StatusServiceUnavailable, Errors: []APIErrorDetail{{Code: 503, Message: "quota service unavailable"}}}, target: ErrQuotaExceeded, want: false},
+		{name: "401 is unauthorized", err: &APIError{StatusCode: http.StatusUnauthorized}, target: ErrUnauthorized, want: true},
+		{name: "403 is unauthorized", err: &APIError{StatusCode: http.StatusForbidden}, target: ErrUnauthorized, want: true},
+		{name: "500 is none of them", err: &APIError{StatusCode: http.StatusInternalServerError}, target: ErrNotFound, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ListFilter narrows a list request. Set fields are sent as query parameters. The
+// API ignores parameters a collection does not support, so callers that need an
+// exact result must filter the response as well.
+type ListFilter struct {
+	Name     string
+	Type     string
+	InUse    *bool
+	IsCustom *bool
+	Attached *bool
+	// Tags limits the result to objects carrying every one of these tags.
+	Tags map[string]string
+}
+
+// path returns base with the filter's query parameters appended.
+func (f *ListFilter) path(base string) string {
+	if f == nil {
+		return base
+	}
+
+	params := url.Values{}
+	if f.Name != "" {
+		params.Set("name", f.Name)
+	}
+	if f.Type != "" {
+		params.Set("type", f.Type)
+	}
+	if f.InUse != nil {
+		params.Set("inUse", strconv.FormatBool(*f.InUse))
+	}
+	if f.IsCustom != nil {
+		params.Set("isCustom", strconv.FormatBool(*f.IsCustom))
+	}
+	if f.Attached != nil {
+		params.Set("attached", strconv.FormatBool(*f.Attached))
+	}
+	for k, v := range f.Tags {
+		params.Set("tag:"+k, v)
+	}
+
+	if len(params) == 0 {
+		return base
+	}
+	return base + "?" + params.Encode()
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GetImageByID fetches an image by its ID. Use GetImage to look one up by slug or name.
+func (c *Client) GetImageByID(ctx context.Context, id int64, opts *RequestOpts) (*Image, error) {
+	var img Image
+	path := fmt.Sprintf("/api/v2/images/%d", id)
+	if err := c.Do(ctx, http.MethodGet, path, nil, &img, opts); err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+// WaitForImageReady polls GetImageByID until the image reaches a ready status.
+func (c *Client) WaitForImageReady(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) (*Image, error) {
+	get := func(ctx context.Context) (*Image, error) { return c.GetImageByID(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(i *Image) string { return i.Status })
+}
+
+// WaitForImageDeleted polls GetImageByID until the image no longer exists.
+func (c *Client) WaitForImageDeleted(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) error {
+	get := func(ctx context.Context) (*Image, error) { return c.GetImageByID(ctx, id, opts) }
+	return waitForDeleted(ctx, timeout, get, func(i *Image) string { return i.Status })
+}
+
+// CreateImageRequest creates a custom image. Exactly one of InstanceID or
+// SnapshotID must be set.
+type CreateImageRequest struct {
+	Region     string            `json:"region"`
+	ProjectID  int64             `json:"projectId"`
+	Name       string            `json:"name"`
+	InstanceID int64             `json:"instanceId,omitempty"`
+	SnapshotID int64             `json:"snapshotId,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+}
+
+func (c *Client) CreateImage(ctx context.Context, req CreateImageRequest) (*Image, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	var img Image
+	if err := c.Do(ctx, http.MethodPost, "/api/v2/images", req, &img, nil); err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+type UpdateImageRequest struct {
+	Region    string            `json:"region,omitempty"`
+	ProjectID int64             `json:"projectId,omitempty"`
+	Name      string            `json:"name"`
+	Tags      map[string]string `json:"tags"`
+}
+
+func (c *Client) UpdateImage(ctx context.Context, id int64, req UpdateImageRequest) (*Image, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/images/%d", id)
+	var img Image
+	if err := c.Do(ctx, http.MethodPut, path, req, &img, nil); err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+func (c *Client) DeleteImage(ctx context.Context, id int64, opts *RequestOpts) error {
+	path := fmt.Sprintf("/api/v2/images/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Instance represents a virtual machine.
+type Instance struct {
+	ID                int64              `json:"id"`
+	Name              string             `json:"name"`
+	Status            string             `json:"status"`
+	ImageID           int64              `json:"imageId"`
+	CPU               int64              `json:"cpu"`
+	RAM               int64              `json:"ram"`
+	DiskSize          int64              `json:"diskSize"`
+	DiskType          string             `json:"diskType"`
+	VolumeIDs         []int64            `json:"volumeIds"`
+	PublicIPs         []InstancePublicIP `json:"publicIps"`
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+	Tags              map[string]string  `json:"tags"`
+}
+
+// InstancePublicIP is a public IP address bound to an instance.
+type InstancePublicIP struct {
+	ID int64  `json:"id"`
+	IP string `json:"ip"`
+}
+
+// NetworkInterface connects an instance to a local network.
+type NetworkInterface struct {
+	LocalNetworkID int64  `json:"localNetworkId"`
+	IP             string `json:"ip"`
+	MAC            string `json:"mac"`
+}
+
+// NetworkInterface returns the instance's interface on the given local network, or nil.
+func (i *Instance) NetworkInterface(localNetworkID int64) *NetworkInterface {
+	for n := range i.NetworkInterfaces {
+		if i.NetworkInterfaces[n].LocalNetworkID == localNetworkID {
+			return &i.NetworkInterfaces[n]
+		}
+	}
+	return nil
+}
+
+func (c *Client) GetInstances(ctx context.Context, opts *RequestOpts) ([]Instance, error) {
+	return listAll[Instance](ctx, c, "/api/v2/instances", opts)
+}
+
+func (c *Client) GetInstance(ctx context.Context, id int64, opts *RequestOpts) (*Instance, error) {
+	var instance Instance
+	path := fmt.Sprintf("/api/v2/instances/%d", id)
+	if err := c.Do(ctx, http.MethodGet, path, nil, &instance, opts); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+// WaitForInstanceReady polls GetInstance until the instance reaches a ready status.
+func (c *Client) WaitForInstanceReady(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) (*Instance, error) {
+	get := func(ctx context.Context) (*Instance, error) { return c.GetInstance(ctx, id, opts) }
+	return waitForReady(ctx, timeout, get, func(i *Instance) string { return i.Status })
+}
+
+// WaitForInstanceDeleted polls GetInstance until the instance no longer exists.
+func (c *Client) WaitForInstanceDeleted(ctx context.Context, id int64, opts *RequestOpts, timeout time.Duration) error {
+	get := func(ctx context.Context) (*Instance, error) { return c.GetInstance(ctx, id, opts) }
+	return waitForDeleted(ctx, timeout, get, func(i *Instance) string { return i.Status })
+}
+
+type CreateInstanceRequest struct {
+	Region          string            `json:"region"`
+	ProjectID       int64             `json:"projectId"`
+	Name            string            `json:"name"`
+	ImageID         int64             `json:"imageId"`
+	CPU             int64             `json:"cpu"`
+	RAM             int64             `json:"ram"`
+	DiskSize        int64             `json:"diskSize"`
+	DiskType        string            `json:"diskType"`
+	SSHKeys         []string          `json:"sshKeys,omitempty"`
+	LocalNetworkIDs []int64           `json:"localNetworkIds,omitempty"`
+	PublicIPIDs     []int64           `json:"publicIpIds,omitempty"`
+	UserData        string            `json:"userData,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+func (c *Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (*Instance, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	var instance Instance
+	if err := c.Do(ctx, http.MethodPost, "/api/v2/instances", req, &instance, nil); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+// UpdateInstanceRequest renames or resizes an instance. The disk can only grow.
+type UpdateInstanceRequest struct {
+	Region    string            `json:"region,omitempty"`
+	ProjectID int64             `json:"projectId,omitempty"`
+	Name      string            `json:"name"`
+	CPU       int64             `json:"cpu"`
+	RAM       int64             `json:"ram"`
+	DiskSize  int64             `json:"diskSize"`
+	Tags      map[string]string `json:"tags"`
+}
+
+func (c *Client) UpdateInstance(ctx context.Context, id int64, req UpdateInstanceRequest) (*Instance, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/instances/%d", id)
+	var instance Instance
+	if err := c.Do(ctx, http.MethodPut, path, req, &instance, nil); err != nil {
+		return nil, err
+	}
+	return &instance, nil
+}
+
+func (c *Client) DeleteInstance(ctx context.Context, id int64, opts *RequestOpts) error {
+	path := fmt.Sprintf("/api/v2/instances/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// logSubsystem is the tflog subsystem used for API traffic. Its level can be set
+	// independently of the provider through TF_LOG_PROVIDER_PRODATA_HTTP.
+	logSubsystem = "http"
+
+	// wireLogEnv must be set (e.g., to TRACE) to log full request and response dumps.
+	// Dumps are redacted, but still contain object names and addresses.
+	wireLogEnv = "TF_LOG_PROVIDER_PRODATA_HTTP"
+
+	redacted = "***"
+)
+
+// sensitiveHeaders are never logged in clear text.
+var sensitiveHeaders = []string{
+	"X-Api-Secret-Key",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields are matched case-insensitively, ignoring `_` and `-`, against
+// JSON keys of logged bodies. Any key containing one of them is redacted, so new
+// API fields such as `rootPassword` are covered without changes here.
+var sensitiveFields = []string{
+	"password",
+	"secret",
+	"token",
+	"privatekey",
+	"userdata",
+}
+
+// logContext returns a context carrying the HTTP logging subsystem with masking
+// of credentials and sensitive fields applied.
+func (c *Client) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_PRODATA", logSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem,
+		"X-Api-Secret-Key", "api_secret_key", "password", "user_data")
+	if c.apiSecretKey != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, c.apiSecretKey)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, c.apiSecretKey)
+	}
+	return ctx
+}
+
+func wireLogEnabled() bool {
+	return os.Getenv(wireLogEnv) != ""
+}
+
+func logWireRequest(ctx context.Context, req *http.Request, body []byte) {
+	if !wireLogEnabled() {
+		return
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "API request dump", map[string]any{
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(body),
+	})
+}
+
+func logWireResponse(ctx context.Context, resp *http.Response, body []byte) {
+	if !wireLogEnabled() {
+		return
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "API response dump", map[string]any{
+		"status":  resp.Status,
+		"headers": redactHeaders(resp.Header),
+		"body":    redactBody(body),
+	})
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		out[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := out[http.CanonicalHeaderKey(name)]; ok {
+			out[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return out
+}
+
+// redactBody returns body with the values of sensitive JSON fields replaced.
+// Bodies that are not JSON objects or arrays are returned unchanged.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(val)
+			}
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+	}
+	return v
+}
+
+func isSensitiveField(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, field := range sensitiveFields {
+		if strings.Contains(normalized, field) {
+			return true
+		}
+	}
+	return false
+}
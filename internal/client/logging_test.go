@@ -0,0 +1,88 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "not JSON", body: "upstream timed out", want: "upstream timed out"},
+		{name: "scalar JSON", body: `"plain"`, want: `"plain"`},
+		{
+			name: "nothing sensitive",
+			body: `{"name":"web","size":10}`,
+			want: `{"name":"web","size":10}`,
+		},
+		{
+			name: "top-level fields",
+			body: `{"name":"web","password":"hunter2","userData":"#cloud-config"}`,
+			want: `{"name":"web","password":"***","userData":"***"}`,
+		},
+		{
+			name: "key variants",
+			body: `{"rootPassword":"a","api_secret_key":"b","Access-Token":"c","private_key":"d","user_data":"e"}`,
+			want: `{"Access-Token":"***","api_secret_key":"***","private_key":"***","rootPassword":"***","user_data":"***"}`,
+		},
+		{
+			name: "nested objects and arrays",
+			body: `{"data":[{"id":1,"token":"t1"},{"id":2,"meta":{"secret":"s"}}]}`,
+			want: `{"data":[{"id":1,"token":"***"},{"id":2,"meta":{"secret":"***"}}]}`,
+		},
+		{
+			name: "sensitive object is redacted whole",
+			body: `{"secrets":{"a":"1","b":"2"}}`,
+			want: `{"secrets":"***"}`,
+		},
+		{
+			name: "top-level array",
+			body: `[{"password":"x"},"y"]`,
+			want: `[{"password":"***"},"y"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactBody([]byte(tt.body))
+			if !jsonEqual(got, tt.want) {
+				t.Errorf("redactBody(%s) = %s, want %s", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Api-Key-Id", "key-id")
+	h.Set("X-Api-Secret-Key", "secret")
+	h.Set("Authorization", "Bearer token")
+	h.Add("Accept", "application/json")
+	h.Add("Accept", "text/plain")
+
+	want := map[string]string{
+		"X-Api-Key-Id":     "key-id",
+		"X-Api-Secret-Key": "***",
+		"Authorization":    "***",
+		"Accept":           "application/json, text/plain",
+	}
+	if got := redactHeaders(h); !reflect.DeepEqual(got, want) {
+		t.Errorf("redactHeaders() = %v, want %v", got, want)
+	}
+}
+
+// jsonEqual compares two JSON documents, ignoring key order. Strings that are not
+// JSON are compared as they are.
+func jsonEqual(a, b string) bool {
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is the number of objects requested per page from list endpoints.
+	DefaultPageSize = 100
+	// MaxListItems bounds the number of objects a single list call returns. Listing
+	// more than this fails instead of silently truncating.
+	MaxListItems = 10000
+)
+
+// pageMeta is the pagination metadata of a list response. The API paginates either
+// by page number, reporting Total, or by cursor, reporting NextCursor.
+type pageMeta struct {
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"nextCursor"`
+}
+
+// paginate returns an iterator over every object of the collection at path,
+// fetching one page at a time. Iteration stops at the first error, which is yielded
+// with the zero value; this includes context cancellation and collections larger
+// than MaxListItems. A response without pagination metadata is taken to hold the
+// whole collection, so endpoints that do not paginate are read in one request.
+func paginate[T any](ctx context.Context, c *Client, path string, opts *RequestOpts) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		count := 0
+		page := 1
+		cursor := ""
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, fmt.Errorf("list %s: %w", path, err))
+				return
+			}
+
+			params := url.Values{}
+			params.Set("limit", strconv.Itoa(DefaultPageSize))
+			if cursor != "" {
+				params.Set("cursor", cursor)
+			} else {
+				params.Set("page", strconv.Itoa(page))
+			}
+
+			var items []T
+			meta, err := c.do(ctx, http.MethodGet, withQuery(path, params), nil, &items, opts)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if count == MaxListItems {
+					yield(zero, fmt.Errorf("list %s: more than %d objects, narrow the request with a filter", path, MaxListItems))
+					return
+				}
+				count++
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// Responses without pagination metadata hold the whole collection.
+			if meta == nil {
+				return
+			}
+
+			// The API may cap the page size below the requested limit.
+			limit := DefaultPageSize
+			if meta.Limit > 0 {
+				limit = meta.Limit
+			}
+
+			switch {
+			case meta.NextCursor != "":
+				if meta.NextCursor == cursor {
+					yield(zero, fmt.Errorf("list %s: the API returned the same cursor twice", path))
+					return
+				}
+				cursor = meta.NextCursor
+			case cursor != "":
+				return
+			case len(items) == 0, meta.Total > 0 && count >= meta.Total, meta.Total == 0 && len(items) < limit:
+				return
+			default:
+				page++
+			}
+		}
+	}
+}
+
+// listAll collects a paginated collection into a slice.
+func listAll[T any](ctx context.Context, c *Client, path string, opts *RequestOpts) ([]T, error) {
+	var all []T
+	for item, err := range paginate[T](ctx, c, path, opts) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// withQuery appends params to a path that may already carry a query string.
+func withQuery(path string, params url.Values) string {
+	if strings.Contains(path, "?") {
+		return path + "&" + params.Encode()
+	}
+	return path + "?" + params.Encode()
+}
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// page is one canned response of a paginated endpoint.
+type page struct {
+	items []int
+	meta  *pageMeta
+}
+
+// newPagedClient returns a client whose list endpoint serves pages in order, and a
+// pointer to the query strings of the requests it received.
+func newPagedClient(t *testing.T, pages []page) (*Client, *[]string) {
+	t.Helper()
+
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if len(queries) > len(pages) {
+			t.Errorf("unexpected request %d: %s", len(queries), r.URL)
+			http.Error(w, "unexpected request", http.StatusInternalServerError)
+			return
+		}
+
+		p := pages[len(queries)-1]
+		items := p.items
+		if items == nil {
+			items = []int{}
+		}
+		_ = json.NewEncoder(w).Encode(apiResponse[[]int]{Success: true, Data: items, Meta: p.meta})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(Config{APIBaseURL: srv.URL, APIKeyID: "id", APISecretKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, &queries
+}
+
+// seq returns the integers from..to-1.
+func seq(from, to int) []int {
+	s := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func TestListAll(t *testing.T) {
+	full := seq(0, DefaultPageSize)
+
+	tests := []struct {
+		name    string
+		pages   []page
+		want    []int
+		queries []string
+	}{
+		{
+			name:    "no pagination metadata",
+			pages:   []page{{items: []int{1, 2, 3}}},
+			want:    []int{1, 2, 3},
+			queries: []string{"limit=100&page=1"},
+		},
+		{
+			name:    "empty collection",
+			pages:   []page{{meta: &pageMeta{Page: 1, Limit: 100}}},
+			want:    nil,
+			queries: []string{"limit=100&page=1"},
+		},
+		{
+			name: "page numbers with total",
+			pages: []page{
+				{items: full, meta: &pageMeta{Page: 1, Limit: 100, Total: 150}},
+				{items: seq(100, 150), meta: &pageMeta{Page: 2, Limit: 100, Total: 150}},
+			},
+			want:    seq(0, 150),
+			queries: []string{"limit=100&page=1", "limit=100&page=2"},
+		},
+		{
+			name: "full last page without total ends on an empty page",
+			pages: []page{
+				{items: full, meta: &pageMeta{Page: 1, Limit: 100}},
+				{meta: &pageMeta{Page: 2, Limit: 100}},
+			},
+			want:    full,
+			queries: []string{"limit=100&page=1", "limit=100&page=2"},
+		},
+		{
+			name: "short page without total is the last",
+			pages: []page{
+				{items: []int{1, 2}, meta: &pageMeta{Page: 1, Limit: 100}},
+			},
+			want:    []int{1, 2},
+			queries: []string{"limit=100&page=1"},
+		},
+		{
+			name: "page size capped by the API",
+			pages: []page{
+				{items: []int{1, 2}, meta: &pageMeta{Page: 1, Limit: 2}},
+				{items: []int{3}, meta: &pageMeta{Page: 2, Limit: 2}},
+			},
+			want:    []int{1, 2, 3},
+			queries: []string{"limit=100&page=1", "limit=100&page=2"},
+		},
+		{
+			name: "empty page before total is reached",
+			pages: []page{
+				{items: []int{1}, meta: &pageMeta{Page: 1, Limit: 1, Total: 5}},
+				{meta: &pageMeta{Page: 2, Limit: 1, Total: 5}},
+			},
+			want:    []int{1},
+			queries: []string{"limit=100&page=1", "limit=100&page=2"},
+		},
+		{
+			name: "cursor",
+			pages: []page{
+				{items: []int{1, 2}, meta: &pageMeta{NextCursor: "abc"}},
+				{items: []int{3}, meta: &pageMeta{NextCursor: "def"}},
+				{items: []int{}, meta: &pageMeta{}},
+			},
+			want:    []int{1, 2, 3},
+			queries: []string{"limit=100&page=1", "cursor=abc&limit=100", "cursor=def&limit=100"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, queries := newPagedClient(t, tt.pages)
+
+			got, err := listAll[int](context.Background(), c, "/api/v2/things", nil)
+			if err != nil {
+				t.Fatalf("listAll() error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("listAll() = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(*queries, tt.queries) {
+				t.Errorf("queries = %q, want %q", *queries, tt.queries)
+			}
+		})
+	}
+}
+
+func TestListAllKeepsFilterQuery(t *testing.T) {
+	c, queries := newPagedClient(t, []page{{items: []int{1}}})
+
+	filter := &ListFilter{Name: "web"}
+	if _, err := listAll[int](context.Background(), c, filter.path("/api/v2/things"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"name=web&limit=100&page=1"}; !slices.Equal(*queries, want) {
+		t.Errorf("queries = %q, want %q", *queries, want)
+	}
+}
+
+func TestListAllRepeatedCursor(t *testing.T) {
+	c, _ := newPagedClient(t, []page{
+		{items: []int{1}, meta: &pageMeta{NextCursor: "abc"}},
+		{items: []int{2}, meta: &pageMeta{NextCursor: "abc"}},
+	})
+
+	_, err := listAll[int](context.Background(), c, "/api/v2/things", nil)
+	if err == nil || !strings.Contains(err.Error(), "same cursor twice") {
+		t.Errorf("listAll() error = %v, want a repeated cursor error", err)
+	}
+}
+
+func TestListAllTooManyItems(t *testing.T) {
+	pages := make([]page, MaxListItems/DefaultPageSize+1)
+	for i := range pages {
+		pages[i] = page{items: seq(i*DefaultPageSize, (i+1)*DefaultPageSize), meta: &pageMeta{Page: i + 1, Limit: DefaultPageSize}}
+	}
+	c, _ := newPagedClient(t, pages)
+
+	_, err := listAll[int](context.Background(), c, "/api/v2/things", nil)
+	if err == nil || !strings.Contains(err.Error(), strconv.Itoa(MaxListItems)) {
+		t.Errorf("listAll() error = %v, want a too many objects error", err)
+	}
+}
+
+func TestPaginateStopsEarly(t *testing.T) {
+	c, queries := newPagedClient(t, []page{
+		{items: seq(0, DefaultPageSize), meta: &pageMeta{Page: 1, Limit: DefaultPageSize, Total: 500}},
+	})
+
+	var got []int
+	for item, err := range paginate[int](context.Background(), c, "/api/v2/things", nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("got %v, want [0 1 2]", got)
+	}
+	if len(*queries) != 1 {
+		t.Errorf("made %d requests, want 1", len(*queries))
+	}
+}
+
+func TestPaginateCanceledContext(t *testing.T) {
+	c, queries := newPagedClient(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := listAll[int](ctx, c, "/api/v2/things", nil); err == nil {
+		t.Error("listAll() succeeded with a canceled context")
+	}
+	if len(*queries) != 0 {
+		t.Errorf("made %d requests, want 0", len(*queries))
+	}
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Project groups the resources of an account. Projects are not tied to a region.
+type Project struct {
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	Tags      map[string]string `json:"tags"`
+	CreatedAt string            `json:"createdAt"`
+}
+
+func (c *Client) GetProjects(ctx context.Context, filter *ListFilter) ([]Project, error) {
+	return listAll[Project](ctx, c, filter.path("/api/v2/projects"), nil)
+}
+
+func (c *Client) GetProject(ctx context.Context, id int64) (*Project, error) {
+	var project Project
+	path := fmt.Sprintf("/api/v2/projects/%d", id)
+	if err := c.Do(ctx, http.MethodGet, path, nil, &project, nil); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+type CreateProjectRequest struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+func (c *Client) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	var project Project
+	if err := c.Do(ctx, http.MethodPost, "/api/v2/projects", req, &project, nil); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+type UpdateProjectRequest struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+func (c *Client) UpdateProject(ctx context.Context, id int64, req UpdateProjectRequest) (*Project, error) {
+	path := fmt.Sprintf("/api/v2/projects/%d", id)
+	var project Project
+	if err := c.Do(ctx, http.MethodPut, path, req, &project, nil); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// DeleteProject deletes a project. The API refuses to delete a project that still
+// holds resources.
+func (c *Client) DeleteProject(ctx context.Context, id int64) error {
+	path := fmt.Sprintf("/api/v2/projects/%d", id)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
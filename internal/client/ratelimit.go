@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRequestsPerSecond = 10
+	DefaultBurst             = 10
+)
+
+// rateLimiter is a token bucket shared by every request sent through a Client,
+// so concurrent resource operations cannot exceed the configured request rate.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a limiter allowing rps requests per second with bursts of
+// up to burst requests. It returns nil, meaning unlimited, when rps is zero.
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if rps <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rps)))
+	}
+
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// release returns a reserved token that was not used.
+func (l *rateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// wait blocks until a request may be sent or ctx is done. A nil limiter never blocks.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	delay := l.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Throttling API request", map[string]any{
+		"delay":               delay.String(),
+		"requests_per_second": l.rate,
+		"burst":               int(l.burst),
+	})
+
+	if err := sleep(ctx, delay); err != nil {
+		l.release()
+		return err
+	}
+	return nil
+}
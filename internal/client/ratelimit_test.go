@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		rps       float64
+		burst     int
+		wantNil   bool
+		wantBurst float64
+	}{
+		{name: "disabled", rps: 0, wantNil: true},
+		{name: "negative rate is disabled", rps: -1, wantNil: true},
+		{name: "explicit burst", rps: 10, burst: 5, wantBurst: 5},
+		{name: "burst defaults to the rate", rps: 4, wantBurst: 4},
+		{name: "fractional rate rounds the burst up", rps: 2.5, wantBurst: 3},
+		{name: "slow rate has a burst of one", rps: 0.2, wantBurst: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(tt.rps, tt.burst)
+			if tt.wantNil {
+				if l != nil {
+					t.Fatalf("newRateLimiter(%v, %d) = %+v, want nil", tt.rps, tt.burst, l)
+				}
+				return
+			}
+			if l.burst != tt.wantBurst || l.tokens != tt.wantBurst {
+				t.Errorf("burst = %v, tokens = %v, want %v", l.burst, l.tokens, tt.wantBurst)
+			}
+		})
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := newRateLimiter(10, 3)
+
+	for i := range 3 {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("reservation %d within the burst waits %v, want 0", i+1, d)
+		}
+	}
+
+	// Every reservation past the burst waits one more interval of 1/rps.
+	for i, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond} {
+		if d := l.reserve(); !about(d, want) {
+			t.Errorf("reservation %d past the burst waits %v, want about %v", i+1, d, want)
+		}
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    time.Duration
+	}{
+		{name: "no time elapsed", elapsed: 0, want: 100 * time.Millisecond},
+		{name: "part of a token", elapsed: 40 * time.Millisecond, want: 60 * time.Millisecond},
+		{name: "one token", elapsed: 100 * time.Millisecond, want: 0},
+		{name: "refill is capped at the burst", elapsed: time.Hour, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(10, 2)
+			l.tokens = 0
+			l.last = time.Now().Add(-tt.elapsed)
+
+			if d := l.reserve(); !about(d, tt.want) {
+				t.Errorf("reserve() = %v, want about %v", d, tt.want)
+			}
+			if l.tokens > l.burst {
+				t.Errorf("tokens = %v, exceeds the burst of %v", l.tokens, l.burst)
+			}
+		})
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	l := newRateLimiter(1000, 1)
+
+	start := time.Now()
+	for range 3 {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first request uses the burst, the other two wait 1ms each.
+	if elapsed := time.Since(start); elapsed < 2*time.Millisecond {
+		t.Errorf("three requests took %v, want at least 2ms", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := newRateLimiter(1, 1)
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("first wait() error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); err == nil {
+		t.Fatal("wait() succeeded with a canceled context")
+	}
+
+	// The canceled request gave its token back, so the next one waits for a single
+	// refill rather than two.
+	if d := l.reserve(); !about(d, time.Second) {
+		t.Errorf("reserve() after the canceled wait = %v, want about 1s", d)
+	}
+}
+
+func TestNilRateLimiterWait(t *testing.T) {
+	var l *rateLimiter
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); err != nil {
+		t.Errorf("nil limiter wait() = %v, want nil", err)
+	}
+}
+
+// about reports whether d is want, allowing for the tokens refilled while the test
+// runs, since the limiter reads the clock itself.
+func about(d, want time.Duration) bool {
+	return d <= want && d > want-10*time.Millisecond
+}
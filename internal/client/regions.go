@@ -0,0 +1,17 @@
+package client
+
+import "context"
+
+// Region is a ProData location that resources can be created in.
+type Region struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	BaseURL  string   `json:"baseUrl"`
+	Services []string `json:"services"`
+}
+
+// GetRegions lists the regions available to the account. Regions are not scoped to
+// a project, so no region or project overrides are sent.
+func (c *Client) GetRegions(ctx context.Context) ([]Region, error) {
+	return listAll[Region](ctx, c, "/api/v2/regions", nil)
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// shouldRetry reports whether a request may be sent again after the given outcome.
+//
+// Idempotent methods are retried on transport errors and on 429, 502, 503 and 504.
+// Other methods (POST) are only retried when the API reports that the request was
+// not processed: a 429, 502, 503 or 504 response carrying Retry-After.
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		// After a transport error (connection reset, timeout) it is unknown whether
+		// the server processed the request, so only idempotent requests are repeated.
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method) || resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. Retry-After is honored
+// when present, up to retryWaitMax; otherwise the wait grows exponentially from
+// retryWaitMin, is capped at retryWaitMax and randomized between half and the full
+// value.
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.retryWaitMax)
+		}
+	}
+
+	wait := c.retryWaitMin << attempt
+	if wait <= 0 || wait > c.retryWaitMax {
+		wait = c.retryWaitMax
+	}
+
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	transportErr := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name       string
+		method     string
+		status     int
+		retryAfter string
+		err        error
+		want       bool
+	}{
+		{name: "GET transport error", method: http.MethodGet, err: transportErr, want: true},
+		{name: "DELETE transport error", method: http.MethodDelete, err: transportErr, want: true},
+		{name: "POST transport error", method: http.MethodPost, err: transportErr, want: false},
+
+		{name: "GET 429", method: http.MethodGet, status: http.StatusTooManyRequests, want: true},
+		{name: "PUT 503", method: http.MethodPut, status: http.StatusServiceUnavailable, want: true},
+		{name: "GET 502", method: http.MethodGet, status: http.StatusBadGateway, want: true},
+		{name: "GET 504", method: http.MethodGet, status: http.StatusGatewayTimeout, want: true},
+		{name: "GET 500", method: http.MethodGet, status: http.StatusInternalServerError, want: false},
+		{name: "GET 404", method: http.MethodGet, status: http.StatusNotFound, want: false},
+		{name: "GET 200", method: http.MethodGet, status: http.StatusOK, want: false},
+
+		{name: "POST 429", method: http.MethodPost, status: http.StatusTooManyRequests, want: false},
+		{name: "POST 429 with Retry-After", method: http.MethodPost, status: http.StatusTooManyRequests, retryAfter: "2", want: true},
+		{name: "POST 503", method: http.MethodPost, status: http.StatusServiceUnavailable, want: false},
+		{name: "POST 503 with Retry-After", method: http.MethodPost, status: http.StatusServiceUnavailable, retryAfter: "2", want: true},
+		{name: "POST 500 with Retry-After", method: http.MethodPost, status: http.StatusInternalServerError, retryAfter: "2", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+				if tt.retryAfter != "" {
+					resp.Header.Set("Retry-After", tt.retryAfter)
+				}
+			}
+
+			if got := shouldRetry(context.Background(), tt.method, resp, tt.err); got != tt.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShouldRetryCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	if shouldRetry(ctx, http.MethodGet, resp, nil) {
+		t.Error("shouldRetry() = true for a canceled context")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: "", wantOK: false},
+		{name: "seconds", value: "120", want: 120 * time.Second, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "negative seconds", value: "-1", wantOK: false},
+		{name: "fractional seconds", value: "1.5", wantOK: false},
+		{name: "garbage", value: "soon", wantOK: false},
+		{name: "date in the past", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterFutureDate(t *testing.T) {
+	value := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+
+	got, ok := parseRetryAfter(value)
+	if !ok {
+		t.Fatalf("parseRetryAfter(%q) was not parsed", value)
+	}
+	// HTTP dates have a resolution of one second.
+	if got < 88*time.Second || got > 90*time.Second {
+		t.Errorf("parseRetryAfter(%q) = %v, want about 90s", value, got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := &Client{retryWaitMin: time.Second, retryWaitMax: 30 * time.Second}
+
+	tests := []struct {
+		name     string
+		attempt  int
+		header   string
+		min, max time.Duration
+	}{
+		{name: "first attempt", attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{name: "third attempt", attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "capped at retryWaitMax", attempt: 10, min: 15 * time.Second, max: 30 * time.Second},
+		{name: "shift overflow is capped", attempt: 80, min: 15 * time.Second, max: 30 * time.Second},
+		{name: "Retry-After is honored", attempt: 0, header: "7", min: 7 * time.Second, max: 7 * time.Second},
+		{name: "Retry-After of zero", attempt: 3, header: "0", min: 0, max: 0},
+		{name: "Retry-After is capped at retryWaitMax", attempt: 0, header: strconv.Itoa(6 * 3600), min: 30 * time.Second, max: 30 * time.Second},
+		{name: "invalid Retry-After falls back to backoff", attempt: 0, header: "later", min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			// The backoff is randomized, so check the bounds repeatedly.
+			for range 50 {
+				if got := c.backoff(tt.attempt, resp); got < tt.min || got > tt.max {
+					t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SecurityGroup is a set of firewall rules applied to the instances associated with it.
+type SecurityGroup struct {
+	ID          int64               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Rules       []SecurityGroupRule `json:"rules"`
+	InstanceIDs []int64             `json:"instanceIds"`
+	Tags        map[string]string   `json:"tags"`
+}
+
+// SecurityGroupRule allows traffic matching a direction, protocol and port range
+// from (or to) a CIDR block or the members of another security group.
+type SecurityGroupRule struct {
+	ID                    int64  `json:"id"`
+	Direction             string `json:"direction"`
+	Protocol              string `json:"protocol"`
+	PortRangeMin          *int64 `json:"portRangeMin"`
+	PortRangeMax          *int64 `json:"portRangeMax"`
+	CIDR                  string `json:"cidr"`
+	SourceSecurityGroupID *int64 `json:"sourceSecurityGroupId"`
+	Description           string `json:"description"`
+}
+
+func (c *Client) GetSecurityGroups(ctx context.Context, opts *RequestOpts) ([]SecurityGroup, error) {
+	return listAll[SecurityGroup](ctx, c, "/api/v2/security-groups", opts)
+}
+
+func (c *Client) GetSecurityGroup(ctx context.Context, id int64, opts *RequestOpts) (*SecurityGroup, error) {
+	var group SecurityGroup
+	path := fmt.Sprintf("/api/v2/security-groups/%d", id)
+	if err := c.Do(ctx, http.MethodGet, path, nil, &group, opts); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+type CreateSecurityGroupRequest struct {
+	Region      string            `json:"region"`
+	ProjectID   int64             `json:"projectId"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+func (c *Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroupRequest) (*SecurityGroup, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	var group SecurityGroup
+	if err := c.Do(ctx, http.MethodPost, "/api/v2/security-groups", req, &group, nil); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+type UpdateSecurityGroupRequest struct {
+	Region      string            `json:"region,omitempty"`
+	ProjectID   int64             `json:"projectId,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Tags        map[string]string `json:"tags"`
+}
+
+func (c *Client) UpdateSecurityGroup(ctx context.Context, id int64, req UpdateSecurityGroupRequest) (*SecurityGroup, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/security-groups/%d", id)
+	var group SecurityGroup
+	if err := c.Do(ctx, http.MethodPut, path, req, &group, nil); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (c *Client) DeleteSecurityGroup(ctx context.Context, id int64, opts *RequestOpts) error {
+	path := fmt.Sprintf("/api/v2/security-groups/%d", id) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
+
+type CreateSecurityGroupRuleRequest struct {
+	Region                string `json:"region"`
+	ProjectID             int64  `json:"projectId"`
+	Direction             string `json:"direction"`
+	Protocol              string `json:"protocol"`
+	PortRangeMin          *int64 `json:"portRangeMin,omitempty"`
+	PortRangeMax          *int64 `json:"portRangeMax,omitempty"`
+	CIDR                  string `json:"cidr,omitempty"`
+	SourceSecurityGroupID *int64 `json:"sourceSecurityGroupId,omitempty"`
+	Description           string `json:"description,omitempty"`
+}
+
+func (c *Client) CreateSecurityGroupRule(ctx context.Context, groupID int64, req CreateSecurityGroupRuleRequest) (*SecurityGroupRule, error) {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/security-groups/%d/rules", groupID)
+	var rule SecurityGroupRule
+	if err := c.Do(ctx, http.MethodPost, path, req, &rule, nil); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (c *Client) DeleteSecurityGroupRule(ctx context.Context, groupID, ruleID int64, opts *RequestOpts) error {
+	path := fmt.Sprintf("/api/v2/security-groups/%d/rules/%d", groupID, ruleID) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
+
+type AttachSecurityGroupRequest struct {
+	Region     string `json:"region"`
+	ProjectID  int64  `json:"projectId,omitempty"`
+	InstanceID int64  `json:"instanceId"`
+}
+
+// AttachSecurityGroup applies the security group to an instance.
+func (c *Client) AttachSecurityGroup(ctx context.Context, groupID int64, req AttachSecurityGroupRequest) error {
+	if req.Region == "" {
+		req.Region = c.Region
+	}
+	if req.ProjectID == 0 {
+		req.ProjectID = c.ProjectID
+	}
+
+	path := fmt.Sprintf("/api/v2/security-groups/%d/instances", groupID)
+	if err := c.Do(ctx, http.MethodPost, path, req, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DetachSecurityGroup removes the security group from an instance.
+func (c *Client) DetachSecurityGroup(ctx context.Context, groupID, instanceID int64, opts *RequestOpts) error {
+	path := fmt.Sprintf("/api/v2/security-groups/%d/instances/%d", groupID, instanceID) + regionalQuery(opts)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, opts); err != nil {
+		return err
+	}
+	return nil
+}
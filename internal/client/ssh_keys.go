@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SSHKey is a public key that can be installed on new instances.
+type SSHKey struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	PublicKey   string            `json:"publicKey"`
+	Fingerprint string            `json:"fingerprint"`
+	Tags        map[string]string `json:"tags"`
+}
+
+func (c *Client) GetSSHKeys(ctx context.Context, opts *RequestOpts) ([]SSHKey, error) {
+	return listAll[SSHKey](ctx, c, "/api/v2/ssh-keys", opts)
+}
+
+func (c *Client) GetSSHKey(ctx context.Context, id int64, opts *RequestOpts) (*SSHKey, error) {
+	var key SSHKey
+	path := fmt.Sprintf("/api/v2/ssh-keys/%d", id)
+	if err := c.Do(ctx, http.MethodGet, path, nil, &key, opts); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+type CreateSSHKeyRequest struct {
+	Name      string            `json:"name"`
+	PublicKey string            `json:"publicKey"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+func (c *Client) CreateSSHKey(ctx context.Context, req CreateSSHKeyRequest) (*SSHKey, error) {
+	var key SSHKey
+	if err := c.Do(ctx, http.MethodPost, "/api/v2/ssh-keys", req, &key, nil); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+type UpdateSSHKeyRequest struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+func (c *Client) UpdateSSHKey(ctx context.Context, id int64, req UpdateSSHKeyRequest) (*SSHKey, error) {
+	path := fmt.Sprintf("/api/v2/ssh-keys/%d", id)
+	var key SSHKey
+	if err := c.Do(ctx, http.MethodPut, path, req, &key, nil); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (c *Client) DeleteSSHKey(ctx context.Context, id int64) error {
+	path := fmt.Sprintf("/api/v2/ssh-keys/%d", id)
+	if err := c.Do(ctx, http.MethodDelete, path, nil, nil, nil); err != nil {
+		return err
+	}
+	return nil
+}
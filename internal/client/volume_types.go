@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"strings"
+)
+
+// VolumeType describes a volume type offered in a region and its size limits in GB.
+type VolumeType struct {
+	Name    string `json:"name"`
+	MinSize int64  `json:"minSize"`
+	MaxSize int64  `json:"maxSize"`
+}
+
+// volumeTypesEntry is a cached catalogue lookup. err is only set when the region
+// has no catalogue, so that the 404 is not requested again.
+type volumeTypesEntry struct {
+	types []VolumeType
+	err   error
+}
+
+// GetVolumeTypes returns the volume types offered in the request's region. The
+// catalogue rarely changes, so it is fetched once per region and cached for the
+// lifetime of the client. Concurrent callers may fetch the same region; the last
+// result wins.
+func (c *Client) GetVolumeTypes(ctx context.Context, opts *RequestOpts) ([]VolumeType, error) {
+	region := c.Region
+	if opts != nil && opts.Region != "" {
+		region = opts.Region
+	}
+
+	c.volumeTypesMu.Lock()
+	entry, ok := c.volumeTypes[region]
+	c.volumeTypesMu.Unlock()
+	if ok {
+		return entry.types, entry.err
+	}
+
+	types, err := listAll[VolumeType](ctx, c, "/api/v2/volume-types", &RequestOpts{Region: region})
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	c.volumeTypesMu.Lock()
+	if c.volumeTypes == nil {
+		c.volumeTypes = make(map[string]volumeTypesEntry)
+	}
+	c.volumeTypes[region] = volumeTypesEntry{types: types, err: err}
+	c.volumeTypesMu.Unlock()
+	return types, err
+}
+
+// VolumeType returns the named entry of the region's volume type catalogue, or nil
+// if the type is not offered there. Names are compared case-insensitively.
+func (c *Client) VolumeType(ctx context.Context, name string, opts *RequestOpts) (*VolumeType, error) {
+	types, err := c.GetVolumeTypes(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i := range types {
+		if strings.EqualFold(types[i].Name, name) {
+			return &types[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVolumeType(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(apiResponse[[]VolumeType]{Success: true, Data: []VolumeType{
+			{Name: "ssd", MinSize: 10, MaxSize: 1000},
+			{Name: "HDD", MaxSize: 4000},
+		}})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(Config{APIBaseURL: srv.URL, APIKeyID: "id", APISecretKey: "secret", Region: "UZ-5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		typeName string
+		want     string
+	}{
+		{name: "same case", typeName: "HDD", want: "HDD"},
+		{name: "catalogue in lower case", typeName: "SSD", want: "ssd"},
+		{name: "configuration in lower case", typeName: "hdd", want: "HDD"},
+		{name: "not offered", typeName: "NVME"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.VolumeType(context.Background(), tt.typeName, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("VolumeType(%q) = %+v, want nil", tt.typeName, got)
+				}
+				return
+			}
+			if got == nil || got.Name != tt.want {
+				t.Errorf("VolumeType(%q) = %+v, want %s", tt.typeName, got, tt.want)
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Errorf("catalogue requested %d times, want 1", requests)
+	}
+}
+
+func TestGetVolumeTypesCachesMissingCatalogue(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(apiResponse[any]{Errors: []APIErrorDetail{{Code: 404, Message: "not found"}}})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(Config{APIBaseURL: srv.URL, APIKeyID: "id", APISecretKey: "secret", Region: "UZ-5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		if _, err := c.GetVolumeTypes(context.Background(), nil); !IsNotFound(err) {
+			t.Fatalf("GetVolumeTypes() error = %v, want not found", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("catalogue requested %d times, want 1", requests)
+	}
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultPollInterval is a variable so that tests can shorten it.
+var defaultPollInterval = 3 * time.Second
+
+// Statuses reported by the API while an object is being provisioned or changed.
+// Comparisons are case-insensitive.
+var pendingStatuses = []string{
+	"pending",
+	"creating",
+	"provisioning",
+	"building",
+	"updating",
+	"resizing",
+	"starting",
+	"attaching",
+	"detaching",
+}
+
+// Statuses in which an object is being removed.
+var deletingStatuses = []string{
+	"deleting",
+}
+
+// Statuses from which an object does not recover on its own. Waits end as soon as
+// one is reported instead of running into the timeout.
+var failedStatuses = []string{
+	"error",
+	"failed",
+}
+
+// Statuses in which an object is usable. Objects that do not report a status are
+// considered ready.
+var readyStatuses = []string{
+	"",
+	"active",
+	"available",
+	"ready",
+	"running",
+	"stopped",
+	"in-use",
+}
+
+// StateRefreshFunc returns the current object and its status. A nil object with
+// a nil error means the object does not exist.
+type StateRefreshFunc func(ctx context.Context) (any, string, error)
+
+// StateChangeConf describes a wait for an object to reach one of the Target statuses.
+type StateChangeConf struct {
+	// Pending lists the statuses that keep the waiter polling. Any other status
+	// that is not in Target fails the wait. An empty list accepts any status.
+	Pending []string
+	// Target lists the statuses that end the wait. An empty list waits for the
+	// object to disappear.
+	Target []string
+	// Failed lists the statuses that fail the wait immediately, even when Pending
+	// is empty.
+	Failed  []string
+	Refresh StateRefreshFunc
+	Timeout time.Duration
+	// PollInterval defaults to 3 seconds.
+	PollInterval time.Duration
+}
+
+// WaitForState polls Refresh until the object reaches a Target status (or is gone
+// when Target is empty), a Failed or unexpected status is reported, or Timeout
+// elapses.
+func (conf *StateChangeConf) WaitForState(ctx context.Context) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, conf.Timeout)
+	defer cancel()
+
+	interval := conf.PollInterval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+
+	var lastStatus string
+	for {
+		result, status, err := conf.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, conf.timeoutError(lastStatus)
+			}
+			return nil, err
+		}
+
+		if result == nil {
+			if len(conf.Target) == 0 {
+				return nil, nil
+			}
+			// The object may not be visible yet right after creation; keep polling.
+		} else {
+			lastStatus = status
+			if len(conf.Target) > 0 && containsStatus(conf.Target, status) {
+				return result, nil
+			}
+			if containsStatus(conf.Failed, status) {
+				return result, fmt.Errorf("failed with status %q", status)
+			}
+			if len(conf.Pending) > 0 && !containsStatus(conf.Pending, status) {
+				if len(conf.Target) == 0 {
+					return result, fmt.Errorf("unexpected status %q while waiting for deletion", status)
+				}
+				return result, fmt.Errorf("unexpected status %q, expected %s", status, strings.Join(conf.Target, " or "))
+			}
+		}
+
+		tflog.Debug(ctx, "Waiting for status change", map[string]any{
+			"status": lastStatus,
+			"target": conf.Target,
+		})
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, conf.timeoutError(lastStatus)
+		}
+	}
+}
+
+func (conf *StateChangeConf) timeoutError(lastStatus string) error {
+	if len(conf.Target) == 0 {
+		return fmt.Errorf("timeout after %s waiting for deletion (last status: %q)", conf.Timeout, lastStatus)
+	}
+	return fmt.Errorf("timeout after %s waiting for status %s (last status: %q)",
+		conf.Timeout, strings.Join(conf.Target, " or "), lastStatus)
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForReady polls get until the object reports a ready status.
+func waitForReady[T any](ctx context.Context, timeout time.Duration, get func(context.Context) (*T, error), status func(*T) string) (*T, error) {
+	conf := &StateChangeConf{
+		Pending: pendingStatuses,
+		Target:  readyStatuses,
+		Failed:  failedStatuses,
+		Timeout: timeout,
+		Refresh: func(ctx context.Context) (any, string, error) {
+			obj, err := get(ctx)
+			if IsNotFound(err) {
+				return nil, "", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			return obj, status(obj), nil
+		},
+	}
+
+	result, err := conf.WaitForState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	obj, _ := result.(*T)
+	return obj, nil
+}
+
+// waitForDeleted polls get until it reports that the object no longer exists. The
+// object may still report a ready status until the API starts removing it.
+func waitForDeleted[T any](ctx context.Context, timeout time.Duration, get func(context.Context) (*T, error), status func(*T) string) error {
+	conf := &StateChangeConf{
+		Pending: slices.Concat(pendingStatuses, deletingStatuses, readyStatuses),
+		Failed:  failedStatuses,
+		Timeout: timeout,
+		Refresh: func(ctx context.Context) (any, string, error) {
+			obj, err := get(ctx)
+			if IsNotFound(err) {
+				return nil, "", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			return obj, status(obj), nil
+		},
+	}
+
+	_, err := conf.WaitForState(ctx)
+	return err
+}
+
+// waitForAttachment polls get until attachedTo reports instanceID, or no instance
+// when instanceID is zero. Objects in a pending status, or attached to another
+// instance, keep the wait going; a failed status ends it.
+func waitForAttachment[T any](ctx context.Context, timeout time.Duration, instanceID int64, get func(context.Context) (*T, error), status func(*T) string, attachedTo func(*T) *int64) (*T, error) {
+	target := "attached"
+	if instanceID == 0 {
+		target = "detached"
+	}
+
+	conf := &StateChangeConf{
+		Target:  []string{target},
+		Failed:  failedStatuses,
+		Timeout: timeout,
+		Refresh: func(ctx context.Context) (any, string, error) {
+			obj, err := get(ctx)
+			if err != nil {
+				return nil, "", err
+			}
+			attached := attachedTo(obj)
+			switch {
+			case containsStatus(pendingStatuses, status(obj)), containsStatus(failedStatuses, status(obj)):
+				return obj, status(obj), nil
+			case attached == nil:
+				return obj, "detached", nil
+			case *attached == instanceID:
+				return obj, "attached", nil
+			default:
+				return obj, fmt.Sprintf("attached to instance %d", *attached), nil
+			}
+		},
+	}
+
+	result, err := conf.WaitForState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	obj, _ := result.(*T)
+	return obj, nil
+}
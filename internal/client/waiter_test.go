@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// step is one result of a fake refresh. A nil obj with a nil err means the object
+// does not exist.
+type step struct {
+	obj    any
+	status string
+	err    error
+}
+
+// fakeRefresh returns the steps in order and repeats the last one once they run out.
+func fakeRefresh(steps ...step) (StateRefreshFunc, *int) {
+	calls := 0
+	return func(ctx context.Context) (any, string, error) {
+		s := steps[min(calls, len(steps)-1)]
+		calls++
+		return s.obj, s.status, s.err
+	}, &calls
+}
+
+func TestWaitForState(t *testing.T) {
+	obj := &struct{ ID int64 }{ID: 1}
+	refreshErr := errors.New("internal server error")
+
+	tests := []struct {
+		name      string
+		pending   []string
+		target    []string
+		failed    []string
+		steps     []step
+		wantObj   bool
+		wantErr   string
+		wantCalls int
+	}{
+		{
+			name:      "target reached",
+			pending:   []string{"creating"},
+			target:    []string{"active"},
+			steps:     []step{{obj, "creating", nil}, {obj, "creating", nil}, {obj, "active", nil}},
+			wantObj:   true,
+			wantCalls: 3,
+		},
+		{
+			name:      "status is compared case-insensitively",
+			pending:   []string{"creating"},
+			target:    []string{"active"},
+			steps:     []step{{obj, "CREATING", nil}, {obj, "Active", nil}},
+			wantObj:   true,
+			wantCalls: 2,
+		},
+		{
+			name:      "unexpected status",
+			pending:   []string{"creating"},
+			target:    []string{"active"},
+			steps:     []step{{obj, "creating", nil}, {obj, "suspended", nil}},
+			wantErr:   `unexpected status "suspended", expected active`,
+			wantCalls: 2,
+		},
+		{
+			name:      "failed status without a pending list",
+			target:    []string{"attached"},
+			failed:    []string{"error"},
+			steps:     []step{{obj, "detached", nil}, {obj, "error", nil}},
+			wantErr:   `failed with status "error"`,
+			wantCalls: 2,
+		},
+		{
+			name:      "not found during create keeps polling",
+			pending:   []string{"creating"},
+			target:    []string{"active"},
+			steps:     []step{{nil, "", nil}, {nil, "", nil}, {obj, "active", nil}},
+			wantObj:   true,
+			wantCalls: 3,
+		},
+		{
+			name:      "disappears on delete",
+			pending:   []string{"active", "deleting"},
+			steps:     []step{{obj, "active", nil}, {obj, "deleting", nil}, {nil, "", nil}},
+			wantCalls: 3,
+		},
+		{
+			name:      "failed while deleting",
+			pending:   []string{"deleting"},
+			failed:    []string{"failed"},
+			steps:     []step{{obj, "deleting", nil}, {obj, "failed", nil}},
+			wantErr:   `failed with status "failed"`,
+			wantCalls: 2,
+		},
+		{
+			name:      "refresh error",
+			target:    []string{"active"},
+			steps:     []step{{obj, "creating", nil}, {nil, "", refreshErr}},
+			wantErr:   "internal server error",
+			wantCalls: 2,
+		},
+		{
+			name:    "timeout",
+			pending: []string{"creating"},
+			target:  []string{"active"},
+			steps:   []step{{obj, "creating", nil}},
+			wantErr: `timeout after 50ms waiting for status active (last status: "creating")`,
+		},
+		{
+			name:    "timeout waiting for deletion",
+			steps:   []step{{obj, "deleting", nil}},
+			wantErr: `timeout after 50ms waiting for deletion (last status: "deleting")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refresh, calls := fakeRefresh(tt.steps...)
+			conf := &StateChangeConf{
+				Pending:      tt.pending,
+				Target:       tt.target,
+				Failed:       tt.failed,
+				Refresh:      refresh,
+				Timeout:      50 * time.Millisecond,
+				PollInterval: time.Millisecond,
+			}
+
+			got, err := conf.WaitForState(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("WaitForState() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("WaitForState() unexpected error: %v", err)
+			}
+
+			if tt.wantObj && got != obj {
+				t.Errorf("WaitForState() = %v, want %v", got, obj)
+			}
+			if !tt.wantObj && tt.wantErr == "" && got != nil {
+				t.Errorf("WaitForState() = %v, want nil", got)
+			}
+			if tt.wantCalls > 0 && *calls != tt.wantCalls {
+				t.Errorf("Refresh called %d times, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWaitForStateCanceledContext(t *testing.T) {
+	refresh, calls := fakeRefresh(step{obj: 1, status: "creating"})
+	conf := &StateChangeConf{
+		Pending:      []string{"creating"},
+		Target:       []string{"active"},
+		Refresh:      refresh,
+		Timeout:      time.Minute,
+		PollInterval: time.Minute,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	if _, err := conf.WaitForState(ctx); err == nil {
+		t.Fatal("WaitForState() succeeded after the context was canceled")
+	}
+	if *calls != 1 {
+		t.Errorf("Refresh called %d times, want 1", *calls)
+	}
+}
+
+func TestWaitForDeleted(t *testing.T) {
+	type object struct{ Status string }
+
+	interval := defaultPollInterval
+	defaultPollInterval = time.Millisecond
+	t.Cleanup(func() { defaultPollInterval = interval })
+
+	tests := []struct {
+		name     string
+		statuses []string
+		wantErr  string
+	}{
+		{name: "still active, then deleting", statuses: []string{"active", "deleting", "DELETING"}},
+		{name: "failed", statuses: []string{"deleting", "error"}, wantErr: `failed with status "error"`},
+		{name: "unexpected status", statuses: []string{"deleting", "suspended"}, wantErr: `unexpected status "suspended" while waiting for deletion`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			get := func(ctx context.Context) (*object, error) {
+				defer func() { calls++ }()
+				if calls < len(tt.statuses) {
+					return &object{Status: tt.statuses[calls]}, nil
+				}
+				return nil, &APIError{StatusCode: http.StatusNotFound}
+			}
+
+			err := waitForDeleted(context.Background(), time.Second, get, func(o *object) string { return o.Status })
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("waitForDeleted() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("waitForDeleted() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
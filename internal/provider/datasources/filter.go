@@ -0,0 +1,251 @@
+package datasources
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"terraform-provider-prodata/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Filter fields, named after their attributes in the filter block.
+const (
+	filterName      = "name"
+	filterNameRegex = "name_regex"
+	filterType      = "type"
+	filterInUse     = "in_use"
+	filterIsCustom  = "is_custom"
+	filterAttached  = "attached"
+	filterTags      = "tags"
+)
+
+// FilterModel holds the `filter` block shared by the list data sources. Each data
+// source supports a subset of the fields; see readFilter.
+type FilterModel struct {
+	Name      types.String `tfsdk:"name"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Type      types.String `tfsdk:"type"`
+	InUse     types.Bool   `tfsdk:"in_use"`
+	IsCustom  types.Bool   `tfsdk:"is_custom"`
+	Attached  types.Bool   `tfsdk:"attached"`
+	// Tags is read as a plain map: data sources are only read once their
+	// configuration is known.
+	Tags map[string]string `tfsdk:"tags"`
+}
+
+// filterItem is the view of a list element that filter, sort_by and most_recent
+// operate on.
+type filterItem struct {
+	ID        int64
+	Name      string
+	Type      string
+	InUse     bool
+	IsCustom  bool
+	Attached  bool
+	Tags      map[string]string
+	CreatedAt string
+}
+
+// filterBlock returns the schema of the `filter` block. noun is the plural of the
+// listed object (e.g. "volumes") and fields the filter fields it supports.
+func filterBlock(noun string, fields ...string) schema.SingleNestedBlock {
+	all := map[string]schema.Attribute{
+		filterName: schema.StringAttribute{
+			MarkdownDescription: "Only return " + noun + " with exactly this name.",
+			Optional:            true,
+		},
+		filterNameRegex: schema.StringAttribute{
+			MarkdownDescription: "Only return " + noun + " whose name matches this regular expression (RE2 syntax).",
+			Optional:            true,
+		},
+		filterType: schema.StringAttribute{
+			MarkdownDescription: "Only return " + noun + " of this type (e.g., HDD, SSD).",
+			Optional:            true,
+		},
+		filterInUse: schema.BoolAttribute{
+			MarkdownDescription: "Only return " + noun + " that are (`true`) or are not (`false`) in use.",
+			Optional:            true,
+		},
+		filterIsCustom: schema.BoolAttribute{
+			MarkdownDescription: "Only return custom images (`true`) or OS templates (`false`).",
+			Optional:            true,
+		},
+		filterAttached: schema.BoolAttribute{
+			MarkdownDescription: "Only return " + noun + " that are (`true`) or are not (`false`) attached to an instance.",
+			Optional:            true,
+		},
+		filterTags: schema.MapAttribute{
+			MarkdownDescription: "Only return " + noun + " that carry all of these tags. Other tags are ignored.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+
+	attrs := make(map[string]schema.Attribute, len(fields))
+	for _, field := range fields {
+		attrs[field] = all[field]
+	}
+
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Narrow the list. All set fields must match. Fields are sent to the API where it supports them and always applied by the provider.",
+		Attributes:          attrs,
+	}
+}
+
+// sortAttributes returns the `sort_by` and `most_recent` attributes.
+func sortAttributes(noun string) (sortBy, mostRecent schema.Attribute) {
+	sortBy = schema.StringAttribute{
+		MarkdownDescription: "Sort the " + noun + " by `id`, `name` or `created_at`, in ascending order. By default the API order is kept.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("id", "name", "created_at"),
+		},
+	}
+	mostRecent = schema.BoolAttribute{
+		MarkdownDescription: "If `true`, only the most recently created of the matching " + noun + " is returned.",
+		Optional:            true,
+	}
+	return sortBy, mostRecent
+}
+
+// readFilter reads the filter block from config. Data sources only declare the
+// fields they support, so each field is read on its own; fields that are not in
+// the schema stay null. It returns nil when the block is absent.
+func readFilter(ctx context.Context, config tfsdk.Config, fields ...string) (*FilterModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var block types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("filter"), &block)...)
+	if diags.HasError() || block.IsNull() {
+		return nil, diags
+	}
+
+	filter := &FilterModel{}
+	targets := map[string]any{
+		filterName:      &filter.Name,
+		filterNameRegex: &filter.NameRegex,
+		filterType:      &filter.Type,
+		filterInUse:     &filter.InUse,
+		filterIsCustom:  &filter.IsCustom,
+		filterAttached:  &filter.Attached,
+		filterTags:      &filter.Tags,
+	}
+	for _, field := range fields {
+		diags.Append(config.GetAttribute(ctx, path.Root("filter").AtName(field), targets[field])...)
+	}
+	return filter, diags
+}
+
+// apiFilter returns the part of the filter block the API can apply.
+func (f *FilterModel) apiFilter() *client.ListFilter {
+	if f == nil {
+		return nil
+	}
+	return &client.ListFilter{
+		Name:     f.Name.ValueString(),
+		Type:     f.Type.ValueString(),
+		InUse:    f.InUse.ValueBoolPointer(),
+		IsCustom: f.IsCustom.ValueBoolPointer(),
+		Attached: f.Attached.ValueBoolPointer(),
+		Tags:     f.Tags,
+	}
+}
+
+// applyListOptions filters items by the filter block, then applies sort_by and
+// most_recent. view maps an element to the fields the options operate on.
+func applyListOptions[T any](diags *diag.Diagnostics, filter *FilterModel, sortBy types.String, mostRecent types.Bool, items []T, view func(T) filterItem) []T {
+	result := filterItems(diags, filter, items, view)
+	if diags.HasError() {
+		return nil
+	}
+
+	switch sortBy.ValueString() {
+	case "id":
+		slices.SortStableFunc(result, func(a, b T) int { return cmp.Compare(view(a).ID, view(b).ID) })
+	case "name":
+		slices.SortStableFunc(result, func(a, b T) int { return cmp.Compare(view(a).Name, view(b).Name) })
+	case "created_at":
+		slices.SortStableFunc(result, func(a, b T) int { return compareCreated(view(a), view(b)) })
+	}
+
+	if mostRecent.ValueBool() && len(result) > 0 {
+		newest := slices.MaxFunc(result, func(a, b T) int { return compareCreated(view(a), view(b)) })
+		result = []T{newest}
+	}
+
+	return result
+}
+
+// filterItems returns the items matching the filter block, keeping their order.
+func filterItems[T any](diags *diag.Diagnostics, filter *FilterModel, items []T, view func(T) filterItem) []T {
+	var nameRegex *regexp.Regexp
+	if filter != nil && !filter.NameRegex.IsNull() {
+		re, err := regexp.Compile(filter.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName(filterNameRegex), "Invalid Regular Expression",
+				fmt.Sprintf("The name_regex could not be compiled: %s.", err))
+			return nil
+		}
+		nameRegex = re
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if filter.matches(view(item), nameRegex) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (f *FilterModel) matches(item filterItem, nameRegex *regexp.Regexp) bool {
+	if f == nil {
+		return true
+	}
+	if !f.Name.IsNull() && item.Name != f.Name.ValueString() {
+		return false
+	}
+	if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+		return false
+	}
+	if !f.Type.IsNull() && item.Type != f.Type.ValueString() {
+		return false
+	}
+	if !f.InUse.IsNull() && item.InUse != f.InUse.ValueBool() {
+		return false
+	}
+	if !f.IsCustom.IsNull() && item.IsCustom != f.IsCustom.ValueBool() {
+		return false
+	}
+	if !f.Attached.IsNull() && item.Attached != f.Attached.ValueBool() {
+		return false
+	}
+	for k, v := range f.Tags {
+		if tag, ok := item.Tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
+// compareCreated orders items by creation time. IDs are assigned in creation
+// order, so they break ties and stand in for timestamps the API does not report.
+func compareCreated(a, b filterItem) int {
+	ta, _ := time.Parse(time.RFC3339, a.CreatedAt)
+	tb, _ := time.Parse(time.RFC3339, b.CreatedAt)
+	if c := ta.Compare(tb); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
@@ -0,0 +1,237 @@
+package datasources
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterItems(t *testing.T) {
+	items := []filterItem{
+		{ID: 1, Name: "web-1", Type: "SSD", Attached: true, Tags: map[string]string{"env": "prod", "team": "web"}},
+		{ID: 2, Name: "web-2", Type: "HDD", Tags: map[string]string{"env": "dev"}},
+		{ID: 3, Name: "db-1", Type: "SSD", InUse: true},
+		{ID: 4, Name: "Web-3", Type: "SSD"},
+	}
+
+	tests := []struct {
+		name    string
+		filter  *FilterModel
+		want    []int64
+		wantErr bool
+	}{
+		{
+			name:   "no filter",
+			filter: nil,
+			want:   []int64{1, 2, 3, 4},
+		},
+		{
+			name:   "empty filter",
+			filter: &FilterModel{},
+			want:   []int64{1, 2, 3, 4},
+		},
+		{
+			name:   "exact name",
+			filter: &FilterModel{Name: types.StringValue("web-1")},
+			want:   []int64{1},
+		},
+		{
+			name:   "name regex is unanchored",
+			filter: &FilterModel{NameRegex: types.StringValue("eb-")},
+			want:   []int64{1, 2, 4},
+		},
+		{
+			name:   "name regex is case sensitive",
+			filter: &FilterModel{NameRegex: types.StringValue("^web-")},
+			want:   []int64{1, 2},
+		},
+		{
+			name:   "name regex with case-insensitive flag",
+			filter: &FilterModel{NameRegex: types.StringValue("(?i)^web-")},
+			want:   []int64{1, 2, 4},
+		},
+		{
+			name:   "name regex matching nothing",
+			filter: &FilterModel{NameRegex: types.StringValue("^cache$")},
+			want:   []int64{},
+		},
+		{
+			name:    "invalid name regex",
+			filter:  &FilterModel{NameRegex: types.StringValue("web-(")},
+			wantErr: true,
+		},
+		{
+			name:   "name and regex must both match",
+			filter: &FilterModel{Name: types.StringValue("db-1"), NameRegex: types.StringValue("^web")},
+			want:   []int64{},
+		},
+		{
+			name:   "type and in_use",
+			filter: &FilterModel{Type: types.StringValue("SSD"), InUse: types.BoolValue(false)},
+			want:   []int64{1, 4},
+		},
+		{
+			name:   "attached false",
+			filter: &FilterModel{Attached: types.BoolValue(false)},
+			want:   []int64{2, 3, 4},
+		},
+		{
+			name:   "all tags must match",
+			filter: &FilterModel{Tags: map[string]string{"env": "prod", "team": "web"}},
+			want:   []int64{1},
+		},
+		{
+			name:   "tag value must match",
+			filter: &FilterModel{Tags: map[string]string{"env": "staging"}},
+			want:   []int64{},
+		},
+		{
+			name:   "other tags are ignored",
+			filter: &FilterModel{Tags: map[string]string{"env": "dev"}},
+			want:   []int64{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := filterItems(&diags, tt.filter, items, func(i filterItem) filterItem { return i })
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tt.wantErr {
+				return
+			}
+			if ids := itemIDs(got); !slices.Equal(ids, tt.want) {
+				t.Errorf("got IDs %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyListOptions(t *testing.T) {
+	items := []filterItem{
+		{ID: 3, Name: "b", CreatedAt: "2024-03-01T00:00:00Z"},
+		{ID: 1, Name: "c", CreatedAt: "2024-05-01T00:00:00Z"},
+		{ID: 2, Name: "a", CreatedAt: "2024-01-01T00:00:00Z"},
+	}
+	// Timestamps the API does not report, or reports identically, fall back to ID order.
+	undated := []filterItem{
+		{ID: 5, Name: "x"},
+		{ID: 9, Name: "x"},
+		{ID: 7, Name: "x", CreatedAt: "not a time"},
+	}
+
+	tests := []struct {
+		name       string
+		items      []filterItem
+		filter     *FilterModel
+		sortBy     types.String
+		mostRecent types.Bool
+		want       []int64
+	}{
+		{
+			name:   "API order is kept by default",
+			items:  items,
+			sortBy: types.StringNull(),
+			want:   []int64{3, 1, 2},
+		},
+		{
+			name:   "sort by id",
+			items:  items,
+			sortBy: types.StringValue("id"),
+			want:   []int64{1, 2, 3},
+		},
+		{
+			name:   "sort by name",
+			items:  items,
+			sortBy: types.StringValue("name"),
+			want:   []int64{2, 3, 1},
+		},
+		{
+			name:   "sort by created_at",
+			items:  items,
+			sortBy: types.StringValue("created_at"),
+			want:   []int64{2, 3, 1},
+		},
+		{
+			name:   "sort by name is stable for equal names",
+			items:  undated,
+			sortBy: types.StringValue("name"),
+			want:   []int64{5, 9, 7},
+		},
+		{
+			name:   "sort by created_at falls back to id",
+			items:  undated,
+			sortBy: types.StringValue("created_at"),
+			want:   []int64{5, 7, 9},
+		},
+		{
+			name:       "most recent",
+			items:      items,
+			mostRecent: types.BoolValue(true),
+			want:       []int64{1},
+		},
+		{
+			name:       "most recent without timestamps picks the highest id",
+			items:      undated,
+			mostRecent: types.BoolValue(true),
+			want:       []int64{9},
+		},
+		{
+			name:       "most recent applies after the filter",
+			items:      items,
+			filter:     &FilterModel{NameRegex: types.StringValue("^[ab]$")},
+			mostRecent: types.BoolValue(true),
+			want:       []int64{3},
+		},
+		{
+			name:       "most recent of nothing",
+			items:      nil,
+			mostRecent: types.BoolValue(true),
+			want:       []int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			input := slices.Clone(tt.items)
+			got := applyListOptions(&diags, tt.filter, tt.sortBy, tt.mostRecent, input, func(i filterItem) filterItem { return i })
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if ids := itemIDs(got); !slices.Equal(ids, tt.want) {
+				t.Errorf("got IDs %v, want %v", ids, tt.want)
+			}
+			if ids := itemIDs(input); !slices.Equal(ids, itemIDs(tt.items)) {
+				t.Errorf("input was reordered to %v", ids)
+			}
+		})
+	}
+}
+
+func TestApplyListOptionsInvalidRegex(t *testing.T) {
+	var diags diag.Diagnostics
+	got := applyListOptions(&diags, &FilterModel{NameRegex: types.StringValue("[")}, types.StringValue("id"), types.BoolValue(true),
+		[]filterItem{{ID: 1}}, func(i filterItem) filterItem { return i })
+
+	if !diags.HasError() {
+		t.Fatal("expected an error for an invalid regular expression")
+	}
+	if got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func itemIDs(items []filterItem) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -34,6 +35,7 @@ type ImageDataSourceModel struct {
 	// Computed output
 	ID       types.Int64 `tfsdk:"id"`
 	IsCustom types.Bool  `tfsdk:"is_custom"`
+	Tags     types.Map   `tfsdk:"tags"`
 }
 
 func NewImageDataSource() datasource.DataSource {
@@ -53,10 +55,12 @@ func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the image. Used for custom images lookup. Conflicts with `slug`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"slug": schema.StringAttribute{
-				MarkdownDescription: "The slug of the image. Used for OS template lookup (e.g., `ubuntu-22.04`, `debian-11`). Conflicts with `name`.",
+				MarkdownDescription: "The slug of the image (e.g., `ubuntu-22.04`, `debian-11`). Works for both OS templates and custom images created with the `prodata_image` resource. Conflicts with `name`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
@@ -76,6 +80,7 @@ func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Whether this is a custom image (`true`) or OS template (`false`).",
 				Computed:            true,
 			},
+			"tags": tagsAttribute("image"),
 		},
 	}
 }
@@ -135,12 +140,15 @@ func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	image, err := d.client.GetImage(ctx, query)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Image", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Image", err)
 		return
 	}
 
 	data.ID = types.Int64Value(image.ID)
+	data.Name = types.StringValue(image.Name)
+	data.Slug = types.StringValue(image.Slug)
 	data.IsCustom = types.BoolValue(image.IsCustom)
+	data.Tags = tagsValue(image.Tags)
 
 	tflog.Debug(ctx, "Successfully read image", map[string]any{
 		"id":        data.ID.ValueInt64(),
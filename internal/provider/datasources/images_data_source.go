@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,18 +23,26 @@ type ImagesDataSource struct {
 }
 
 type ImagesDataSourceModel struct {
-	Region    types.String `tfsdk:"region"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
-	Images    []ImageModel `tfsdk:"images"`
+	Region     types.String `tfsdk:"region"`
+	ProjectID  types.Int64  `tfsdk:"project_id"`
+	Filter     types.Object `tfsdk:"filter"`
+	SortBy     types.String `tfsdk:"sort_by"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Images     []ImageModel `tfsdk:"images"`
 }
 
 type ImageModel struct {
-	ID       types.Int64  `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Slug     types.String `tfsdk:"slug"`
-	IsCustom types.Bool   `tfsdk:"is_custom"`
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Slug      types.String `tfsdk:"slug"`
+	IsCustom  types.Bool   `tfsdk:"is_custom"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
 }
 
+// imageFilterFields are the filter block fields supported by prodata_images.
+var imageFilterFields = []string{filterName, filterNameRegex, filterIsCustom, filterTags}
+
 func NewImagesDataSource() datasource.DataSource {
 	return &ImagesDataSource{}
 }
@@ -43,6 +52,8 @@ func (d *ImagesDataSource) Metadata(ctx context.Context, req datasource.Metadata
 }
 
 func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sortBy, mostRecent := sortAttributes("images")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List all available ProData images (OS templates and custom images).",
 
@@ -55,6 +66,8 @@ func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
 				Optional:            true,
 			},
+			"sort_by":     sortBy,
+			"most_recent": mostRecent,
 			"images": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available images.",
 				Computed:            true,
@@ -76,10 +89,19 @@ func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 							MarkdownDescription: "Whether this is a custom image (`true`) or OS template (`false`).",
 							Computed:            true,
 						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the image was created (RFC 3339).",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("image"),
 					},
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("images", imageFilterFields...),
+		},
 	}
 }
 
@@ -121,19 +143,32 @@ func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		"project_id": opts.ProjectID,
 	})
 
-	images, err := d.client.GetImages(ctx, opts)
+	filter, diags := readFilter(ctx, req.Config, imageFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	images, err := d.client.GetImages(ctx, filter.apiFilter(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to List Images", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Images", err)
+		return
+	}
+
+	images = applyListOptions(&resp.Diagnostics, filter, data.SortBy, data.MostRecent, images, imageFilterItem)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Images = make([]ImageModel, len(images))
 	for i, img := range images {
 		data.Images[i] = ImageModel{
-			ID:       types.Int64Value(img.ID),
-			Name:     types.StringValue(img.Name),
-			Slug:     types.StringValue(img.Slug),
-			IsCustom: types.BoolValue(img.IsCustom),
+			ID:        types.Int64Value(img.ID),
+			Name:      types.StringValue(img.Name),
+			Slug:      types.StringValue(img.Slug),
+			IsCustom:  types.BoolValue(img.IsCustom),
+			CreatedAt: types.StringValue(img.CreatedAt),
+			Tags:      tagsValue(img.Tags),
 		}
 	}
 
@@ -143,3 +178,7 @@ func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func imageFilterItem(img client.Image) filterItem {
+	return filterItem{ID: img.ID, Name: img.Name, IsCustom: img.IsCustom, Tags: img.Tags, CreatedAt: img.CreatedAt}
+}
@@ -0,0 +1,212 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &InstanceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &InstanceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &InstanceDataSource{}
+)
+
+type InstanceDataSource struct {
+	client *client.Client
+}
+
+type InstanceDataSourceModel struct {
+	// Input - one of these required
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Region    types.String `tfsdk:"region"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+
+	// Computed output
+	Status            types.String            `tfsdk:"status"`
+	ImageID           types.Int64             `tfsdk:"image_id"`
+	CPU               types.Int64             `tfsdk:"cpu"`
+	RAM               types.Int64             `tfsdk:"ram"`
+	DiskSize          types.Int64             `tfsdk:"disk_size"`
+	DiskType          types.String            `tfsdk:"disk_type"`
+	VolumeIDs         []types.Int64           `tfsdk:"volume_ids"`
+	PublicIPs         []InstancePublicIPModel `tfsdk:"public_ips"`
+	NetworkInterfaces []NetworkInterfaceModel `tfsdk:"network_interfaces"`
+	Tags              types.Map               `tfsdk:"tags"`
+}
+
+func NewInstanceDataSource() datasource.DataSource {
+	return &InstanceDataSource{}
+}
+
+func (d *InstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance"
+}
+
+func (d *InstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a ProData instance by ID or name.",
+
+		Attributes: map[string]schema.Attribute{
+			// Lookup criteria
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the instance. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the instance. Must match exactly one instance. Conflicts with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
+				Optional:            true,
+			},
+
+			// Computed attributes
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the instance (e.g., `running`).",
+				Computed:            true,
+			},
+			"image_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the image the instance was created from.",
+				Computed:            true,
+			},
+			"cpu": schema.Int64Attribute{
+				MarkdownDescription: "The number of virtual CPU cores.",
+				Computed:            true,
+			},
+			"ram": schema.Int64Attribute{
+				MarkdownDescription: "The amount of memory in GB.",
+				Computed:            true,
+			},
+			"disk_size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the boot disk in GB.",
+				Computed:            true,
+			},
+			"disk_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the boot disk (e.g., HDD, SSD).",
+				Computed:            true,
+			},
+			"volume_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the volumes attached to the instance.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"public_ips":         instancePublicIPsAttribute(),
+			"network_interfaces": networkInterfacesAttribute(),
+			"tags":               tagsAttribute("instance"),
+		},
+	}
+}
+
+func (d *InstanceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *InstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Looking up instance", map[string]any{
+		"id":         data.ID.ValueInt64(),
+		"name":       data.Name.ValueString(),
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	var instance *client.Instance
+	if !data.ID.IsNull() {
+		var err error
+		instance, err = d.client.GetInstance(ctx, data.ID.ValueInt64(), opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Instance", err)
+			return
+		}
+	} else {
+		instances, err := d.client.GetInstances(ctx, opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Instance", err)
+			return
+		}
+
+		name := data.Name.ValueString()
+		found, ok := diagutil.SelectOne(&resp.Diagnostics, path.Root("name"), diagutil.LookupKind{Title: "Instance", Noun: "instance"}, fmt.Sprintf("name %q", name), instances,
+			func(i client.Instance) int64 { return i.ID },
+			func(i client.Instance) bool { return i.Name == name })
+		if !ok {
+			return
+		}
+		instance = &found
+	}
+
+	m := newInstanceModel(instance)
+	data.ID = m.ID
+	data.Name = m.Name
+	data.Status = m.Status
+	data.ImageID = m.ImageID
+	data.CPU = m.CPU
+	data.RAM = m.RAM
+	data.DiskSize = m.DiskSize
+	data.DiskType = m.DiskType
+	data.VolumeIDs = m.VolumeIDs
+	data.PublicIPs = m.PublicIPs
+	data.NetworkInterfaces = m.NetworkInterfaces
+	data.Tags = m.Tags
+
+	tflog.Debug(ctx, "Successfully read instance", map[string]any{
+		"id":   instance.ID,
+		"name": instance.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
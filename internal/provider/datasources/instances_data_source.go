@@ -0,0 +1,285 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &InstancesDataSource{}
+	_ datasource.DataSourceWithConfigure = &InstancesDataSource{}
+)
+
+type InstancesDataSource struct {
+	client *client.Client
+}
+
+type InstancesDataSourceModel struct {
+	Region    types.String    `tfsdk:"region"`
+	ProjectID types.Int64     `tfsdk:"project_id"`
+	Filter    types.Object    `tfsdk:"filter"`
+	Instances []InstanceModel `tfsdk:"instances"`
+}
+
+type InstanceModel struct {
+	ID                types.Int64             `tfsdk:"id"`
+	Name              types.String            `tfsdk:"name"`
+	Status            types.String            `tfsdk:"status"`
+	ImageID           types.Int64             `tfsdk:"image_id"`
+	CPU               types.Int64             `tfsdk:"cpu"`
+	RAM               types.Int64             `tfsdk:"ram"`
+	DiskSize          types.Int64             `tfsdk:"disk_size"`
+	DiskType          types.String            `tfsdk:"disk_type"`
+	VolumeIDs         []types.Int64           `tfsdk:"volume_ids"`
+	PublicIPs         []InstancePublicIPModel `tfsdk:"public_ips"`
+	NetworkInterfaces []NetworkInterfaceModel `tfsdk:"network_interfaces"`
+	Tags              types.Map               `tfsdk:"tags"`
+}
+
+type InstancePublicIPModel struct {
+	ID types.Int64  `tfsdk:"id"`
+	IP types.String `tfsdk:"ip"`
+}
+
+type NetworkInterfaceModel struct {
+	LocalNetworkID types.Int64  `tfsdk:"local_network_id"`
+	IP             types.String `tfsdk:"ip"`
+	MAC            types.String `tfsdk:"mac"`
+}
+
+// instanceFilterFields are the filter block fields supported by prodata_instances.
+var instanceFilterFields = []string{filterTags}
+
+func NewInstancesDataSource() datasource.DataSource {
+	return &InstancesDataSource{}
+}
+
+func (d *InstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instances"
+}
+
+func (d *InstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all available ProData instances.",
+
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
+				Optional:            true,
+			},
+			"instances": schema.ListNestedAttribute{
+				MarkdownDescription: "List of available instances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the instance.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the instance.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the instance (e.g., `running`).",
+							Computed:            true,
+						},
+						"image_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the image the instance was created from.",
+							Computed:            true,
+						},
+						"cpu": schema.Int64Attribute{
+							MarkdownDescription: "The number of virtual CPU cores.",
+							Computed:            true,
+						},
+						"ram": schema.Int64Attribute{
+							MarkdownDescription: "The amount of memory in GB.",
+							Computed:            true,
+						},
+						"disk_size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the boot disk in GB.",
+							Computed:            true,
+						},
+						"disk_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the boot disk (e.g., HDD, SSD).",
+							Computed:            true,
+						},
+						"volume_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the volumes attached to the instance.",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"public_ips":         instancePublicIPsAttribute(),
+						"network_interfaces": networkInterfacesAttribute(),
+						"tags":               tagsAttribute("instance"),
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("instances", instanceFilterFields...),
+		},
+	}
+}
+
+func instancePublicIPsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Public IPs bound to the instance.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					MarkdownDescription: "The unique identifier of the public IP.",
+					Computed:            true,
+				},
+				"ip": schema.StringAttribute{
+					MarkdownDescription: "The public IP address.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func networkInterfacesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Network interfaces connecting the instance to local networks.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"local_network_id": schema.Int64Attribute{
+					MarkdownDescription: "The ID of the local network.",
+					Computed:            true,
+				},
+				"ip": schema.StringAttribute{
+					MarkdownDescription: "The private IP address of the instance in the local network.",
+					Computed:            true,
+				},
+				"mac": schema.StringAttribute{
+					MarkdownDescription: "The MAC address of the interface.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *InstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *InstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstancesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Listing instances", map[string]any{
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	filter, diags := readFilter(ctx, req.Config, instanceFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instances, err := d.client.GetInstances(ctx, opts)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Instances", err)
+		return
+	}
+
+	instances = filterItems(&resp.Diagnostics, filter, instances, instanceFilterItem)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Instances = make([]InstanceModel, len(instances))
+	for i := range instances {
+		data.Instances[i] = newInstanceModel(&instances[i])
+	}
+
+	tflog.Debug(ctx, "Successfully listed instances", map[string]any{
+		"count": len(instances),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newInstanceModel(instance *client.Instance) InstanceModel {
+	m := InstanceModel{
+		ID:                types.Int64Value(instance.ID),
+		Name:              types.StringValue(instance.Name),
+		Status:            types.StringValue(instance.Status),
+		ImageID:           types.Int64Value(instance.ImageID),
+		CPU:               types.Int64Value(instance.CPU),
+		RAM:               types.Int64Value(instance.RAM),
+		DiskSize:          types.Int64Value(instance.DiskSize),
+		DiskType:          types.StringValue(instance.DiskType),
+		VolumeIDs:         make([]types.Int64, len(instance.VolumeIDs)),
+		PublicIPs:         make([]InstancePublicIPModel, len(instance.PublicIPs)),
+		NetworkInterfaces: make([]NetworkInterfaceModel, len(instance.NetworkInterfaces)),
+		Tags:              tagsValue(instance.Tags),
+	}
+
+	for i, id := range instance.VolumeIDs {
+		m.VolumeIDs[i] = types.Int64Value(id)
+	}
+	for i, ip := range instance.PublicIPs {
+		m.PublicIPs[i] = InstancePublicIPModel{
+			ID: types.Int64Value(ip.ID),
+			IP: types.StringValue(ip.IP),
+		}
+	}
+	for i, nic := range instance.NetworkInterfaces {
+		m.NetworkInterfaces[i] = NetworkInterfaceModel{
+			LocalNetworkID: types.Int64Value(nic.LocalNetworkID),
+			IP:             types.StringValue(nic.IP),
+			MAC:            types.StringValue(nic.MAC),
+		}
+	}
+
+	return m
+}
+
+func instanceFilterItem(i client.Instance) filterItem {
+	return filterItem{ID: i.ID, Name: i.Name, Tags: i.Tags}
+}
@@ -5,16 +5,20 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ datasource.DataSource              = &LocalNetworkDataSource{}
-	_ datasource.DataSourceWithConfigure = &LocalNetworkDataSource{}
+	_ datasource.DataSource                     = &LocalNetworkDataSource{}
+	_ datasource.DataSourceWithConfigure        = &LocalNetworkDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &LocalNetworkDataSource{}
 )
 
 type LocalNetworkDataSource struct {
@@ -29,6 +33,7 @@ type LocalNetworkDataSourceModel struct {
 	CIDR      types.String `tfsdk:"cidr"`
 	Gateway   types.String `tfsdk:"gateway"`
 	Linked    types.Bool   `tfsdk:"linked"`
+	Tags      types.Map    `tfsdk:"tags"`
 }
 
 func NewLocalNetworkDataSource() datasource.DataSource {
@@ -41,12 +46,13 @@ func (d *LocalNetworkDataSource) Metadata(ctx context.Context, req datasource.Me
 
 func (d *LocalNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Lookup a ProData local network by ID.",
+		MarkdownDescription: "Lookup a ProData local network by ID, name or CIDR block.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "The unique identifier of the local network.",
-				Required:            true,
+				MarkdownDescription: "The unique identifier of the local network. Conflicts with `name` and `cidr`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
@@ -57,11 +63,13 @@ func (d *LocalNetworkDataSource) Schema(ctx context.Context, req datasource.Sche
 				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the local network.",
+				MarkdownDescription: "The name of the local network. Exactly one local network must have this name. Conflicts with `id` and `cidr`.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"cidr": schema.StringAttribute{
-				MarkdownDescription: "The CIDR block of the local network.",
+				MarkdownDescription: "The CIDR block of the local network. Exactly one local network must use this block. Conflicts with `id` and `name`.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"gateway": schema.StringAttribute{
@@ -72,10 +80,21 @@ func (d *LocalNetworkDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Whether the local network is linked to an instance.",
 				Computed:            true,
 			},
+			"tags": tagsAttribute("local network"),
 		},
 	}
 }
 
+func (d *LocalNetworkDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("cidr"),
+		),
+	}
+}
+
 func (d *LocalNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,27 +128,61 @@ func (d *LocalNetworkDataSource) Read(ctx context.Context, req datasource.ReadRe
 		opts.ProjectID = data.ProjectID.ValueInt64()
 	}
 
-	networkID := data.ID.ValueInt64()
-
-	tflog.Debug(ctx, "Reading local network", map[string]any{
-		"id":         networkID,
-		"region":     opts.Region,
-		"project_id": opts.ProjectID,
-	})
-
-	network, err := d.client.GetLocalNetwork(ctx, networkID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Local Network", err.Error())
-		return
+	var network *client.LocalNetwork
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading local network", map[string]any{
+			"id":         data.ID.ValueInt64(),
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		var err error
+		network, err = d.client.GetLocalNetwork(ctx, data.ID.ValueInt64(), opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Local Network", err)
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+		cidr := data.CIDR.ValueString()
+
+		tflog.Debug(ctx, "Looking up local network", map[string]any{
+			"name":       name,
+			"cidr":       cidr,
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		networks, err := d.client.GetLocalNetworks(ctx, &client.ListFilter{Name: name}, opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Local Networks", err)
+			return
+		}
+
+		attr, criteria := path.Root("name"), fmt.Sprintf("name %q", name)
+		match := func(n client.LocalNetwork) bool { return n.Name == name }
+		if !data.CIDR.IsNull() {
+			attr, criteria = path.Root("cidr"), fmt.Sprintf("CIDR %s", cidr)
+			match = func(n client.LocalNetwork) bool { return n.CIDR == cidr }
+		}
+
+		found, ok := diagutil.SelectOne(&resp.Diagnostics, attr, diagutil.LookupKind{Title: "Local Network", Noun: "local network"}, criteria, networks,
+			func(n client.LocalNetwork) int64 { return n.ID }, match)
+		if !ok {
+			return
+		}
+		network = &found
 	}
 
+	data.ID = types.Int64Value(network.ID)
 	data.Name = types.StringValue(network.Name)
 	data.CIDR = types.StringValue(network.CIDR)
 	data.Gateway = types.StringValue(network.Gateway)
 	data.Linked = types.BoolValue(network.Linked)
+	data.Tags = tagsValue(network.Tags)
 
 	tflog.Debug(ctx, "Successfully read local network", map[string]any{
-		"id":   networkID,
+		"id":   network.ID,
 		"name": network.Name,
 	})
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,17 +25,25 @@ type LocalNetworksDataSource struct {
 type LocalNetworksDataSourceModel struct {
 	Region        types.String        `tfsdk:"region"`
 	ProjectID     types.Int64         `tfsdk:"project_id"`
+	Filter        types.Object        `tfsdk:"filter"`
+	SortBy        types.String        `tfsdk:"sort_by"`
+	MostRecent    types.Bool          `tfsdk:"most_recent"`
 	LocalNetworks []LocalNetworkModel `tfsdk:"local_networks"`
 }
 
 type LocalNetworkModel struct {
-	ID      types.Int64  `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	CIDR    types.String `tfsdk:"cidr"`
-	Gateway types.String `tfsdk:"gateway"`
-	Linked  types.Bool   `tfsdk:"linked"`
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CIDR      types.String `tfsdk:"cidr"`
+	Gateway   types.String `tfsdk:"gateway"`
+	Linked    types.Bool   `tfsdk:"linked"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
 }
 
+// localNetworkFilterFields are the filter block fields supported by prodata_local_networks.
+var localNetworkFilterFields = []string{filterName, filterNameRegex, filterAttached, filterTags}
+
 func NewLocalNetworksDataSource() datasource.DataSource {
 	return &LocalNetworksDataSource{}
 }
@@ -44,6 +53,8 @@ func (d *LocalNetworksDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *LocalNetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sortBy, mostRecent := sortAttributes("local networks")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List all available ProData local networks.",
 
@@ -56,6 +67,8 @@ func (d *LocalNetworksDataSource) Schema(ctx context.Context, req datasource.Sch
 				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
 				Optional:            true,
 			},
+			"sort_by":     sortBy,
+			"most_recent": mostRecent,
 			"local_networks": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available local networks.",
 				Computed:            true,
@@ -81,10 +94,19 @@ func (d *LocalNetworksDataSource) Schema(ctx context.Context, req datasource.Sch
 							MarkdownDescription: "Whether the local network is linked to an instance.",
 							Computed:            true,
 						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the local network was created (RFC 3339).",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("local network"),
 					},
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("local networks", localNetworkFilterFields...),
+		},
 	}
 }
 
@@ -126,20 +148,33 @@ func (d *LocalNetworksDataSource) Read(ctx context.Context, req datasource.ReadR
 		"project_id": opts.ProjectID,
 	})
 
-	networks, err := d.client.GetLocalNetworks(ctx, opts)
+	filter, diags := readFilter(ctx, req.Config, localNetworkFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networks, err := d.client.GetLocalNetworks(ctx, filter.apiFilter(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to List Local Networks", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Local Networks", err)
+		return
+	}
+
+	networks = applyListOptions(&resp.Diagnostics, filter, data.SortBy, data.MostRecent, networks, localNetworkFilterItem)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.LocalNetworks = make([]LocalNetworkModel, len(networks))
 	for i, net := range networks {
 		data.LocalNetworks[i] = LocalNetworkModel{
-			ID:      types.Int64Value(net.ID),
-			Name:    types.StringValue(net.Name),
-			CIDR:    types.StringValue(net.CIDR),
-			Gateway: types.StringValue(net.Gateway),
-			Linked:  types.BoolValue(net.Linked),
+			ID:        types.Int64Value(net.ID),
+			Name:      types.StringValue(net.Name),
+			CIDR:      types.StringValue(net.CIDR),
+			Gateway:   types.StringValue(net.Gateway),
+			Linked:    types.BoolValue(net.Linked),
+			CreatedAt: types.StringValue(net.CreatedAt),
+			Tags:      tagsValue(net.Tags),
 		}
 	}
 
@@ -149,3 +184,7 @@ func (d *LocalNetworksDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func localNetworkFilterItem(n client.LocalNetwork) filterItem {
+	return filterItem{ID: n.ID, Name: n.Name, Attached: n.Linked, Tags: n.Tags, CreatedAt: n.CreatedAt}
+}
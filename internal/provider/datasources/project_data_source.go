@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &ProjectDataSource{}
+	_ datasource.DataSourceWithConfigure        = &ProjectDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+)
+
+type ProjectDataSource struct {
+	client *client.Client
+}
+
+type ProjectDataSourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a ProData project by ID or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the project. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project. Exactly one project must have this name. Conflicts with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the project was created (RFC 3339).",
+				Computed:            true,
+			},
+			"tags": tagsAttribute("project"),
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *client.Project
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading project", map[string]any{
+			"id": data.ID.ValueInt64(),
+		})
+
+		var err error
+		project, err = d.client.GetProject(ctx, data.ID.ValueInt64())
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Project", err)
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+
+		tflog.Debug(ctx, "Looking up project", map[string]any{
+			"name": name,
+		})
+
+		projects, err := d.client.GetProjects(ctx, &client.ListFilter{Name: name})
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Projects", err)
+			return
+		}
+
+		found, ok := diagutil.SelectOne(&resp.Diagnostics, path.Root("name"), diagutil.LookupKind{Title: "Project", Noun: "project", Account: true},
+			fmt.Sprintf("name %q", name), projects,
+			func(p client.Project) int64 { return p.ID },
+			func(p client.Project) bool { return p.Name == name })
+		if !ok {
+			return
+		}
+		project = &found
+	}
+
+	data.ID = types.Int64Value(project.ID)
+	data.Name = types.StringValue(project.Name)
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	data.Tags = tagsValue(project.Tags)
+
+	tflog.Debug(ctx, "Successfully read project", map[string]any{
+		"id":   project.ID,
+		"name": project.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
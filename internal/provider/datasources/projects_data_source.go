@@ -0,0 +1,151 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &ProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProjectsDataSource{}
+)
+
+type ProjectsDataSource struct {
+	client *client.Client
+}
+
+type ProjectsDataSourceModel struct {
+	Filter     types.Object   `tfsdk:"filter"`
+	SortBy     types.String   `tfsdk:"sort_by"`
+	MostRecent types.Bool     `tfsdk:"most_recent"`
+	Projects   []ProjectModel `tfsdk:"projects"`
+}
+
+type ProjectModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+// projectFilterFields are the filter block fields supported by prodata_projects.
+var projectFilterFields = []string{filterName, filterNameRegex, filterTags}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sortBy, mostRecent := sortAttributes("projects")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all ProData projects of the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"sort_by":     sortBy,
+			"most_recent": mostRecent,
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "List of projects.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the project was created (RFC 3339).",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("project"),
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("projects", projectFilterFields...),
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing projects")
+
+	filter, diags := readFilter(ctx, req.Config, projectFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.GetProjects(ctx, filter.apiFilter())
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Projects", err)
+		return
+	}
+
+	projects = applyListOptions(&resp.Diagnostics, filter, data.SortBy, data.MostRecent, projects, projectFilterItem)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Projects = make([]ProjectModel, len(projects))
+	for i, project := range projects {
+		data.Projects[i] = ProjectModel{
+			ID:        types.Int64Value(project.ID),
+			Name:      types.StringValue(project.Name),
+			CreatedAt: types.StringValue(project.CreatedAt),
+			Tags:      tagsValue(project.Tags),
+		}
+	}
+
+	tflog.Debug(ctx, "Successfully listed projects", map[string]any{
+		"count": len(projects),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func projectFilterItem(p client.Project) filterItem {
+	return filterItem{ID: p.ID, Name: p.Name, Tags: p.Tags, CreatedAt: p.CreatedAt}
+}
@@ -5,16 +5,20 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ datasource.DataSource              = &PublicIPDataSource{}
-	_ datasource.DataSourceWithConfigure = &PublicIPDataSource{}
+	_ datasource.DataSource                     = &PublicIPDataSource{}
+	_ datasource.DataSourceWithConfigure        = &PublicIPDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &PublicIPDataSource{}
 )
 
 type PublicIPDataSource struct {
@@ -22,13 +26,15 @@ type PublicIPDataSource struct {
 }
 
 type PublicIPDataSourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	Region    types.String `tfsdk:"region"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
-	Name      types.String `tfsdk:"name"`
-	IP        types.String `tfsdk:"ip"`
-	Mask      types.String `tfsdk:"mask"`
-	Gateway   types.String `tfsdk:"gateway"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Region             types.String `tfsdk:"region"`
+	ProjectID          types.Int64  `tfsdk:"project_id"`
+	Name               types.String `tfsdk:"name"`
+	IP                 types.String `tfsdk:"ip"`
+	Mask               types.String `tfsdk:"mask"`
+	Gateway            types.String `tfsdk:"gateway"`
+	AttachedInstanceID types.Int64  `tfsdk:"attached_instance_id"`
+	Tags               types.Map    `tfsdk:"tags"`
 }
 
 func NewPublicIPDataSource() datasource.DataSource {
@@ -41,12 +47,13 @@ func (d *PublicIPDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *PublicIPDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Lookup a ProData public IP by ID.",
+		MarkdownDescription: "Lookup a ProData public IP by ID, name or address.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "The unique identifier of the public IP.",
-				Required:            true,
+				MarkdownDescription: "The unique identifier of the public IP. Conflicts with `name` and `ip`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
@@ -57,11 +64,13 @@ func (d *PublicIPDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the public IP.",
+				MarkdownDescription: "The name of the public IP. Exactly one public IP must have this name. Conflicts with `id` and `ip`.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"ip": schema.StringAttribute{
-				MarkdownDescription: "The allocated public IP address.",
+				MarkdownDescription: "The allocated public IP address. Conflicts with `id` and `name`.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"mask": schema.StringAttribute{
@@ -72,10 +81,25 @@ func (d *PublicIPDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The gateway IP address.",
 				Computed:            true,
 			},
+			"attached_instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance the address is bound to (if any).",
+				Computed:            true,
+			},
+			"tags": tagsAttribute("public IP"),
 		},
 	}
 }
 
+func (d *PublicIPDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("ip"),
+		),
+	}
+}
+
 func (d *PublicIPDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -109,27 +133,62 @@ func (d *PublicIPDataSource) Read(ctx context.Context, req datasource.ReadReques
 		opts.ProjectID = data.ProjectID.ValueInt64()
 	}
 
-	ipID := data.ID.ValueInt64()
-
-	tflog.Debug(ctx, "Reading public IP", map[string]any{
-		"id":         ipID,
-		"region":     opts.Region,
-		"project_id": opts.ProjectID,
-	})
-
-	ip, err := d.client.GetPublicIP(ctx, ipID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Public IP", err.Error())
-		return
+	var ip *client.PublicIP
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading public IP", map[string]any{
+			"id":         data.ID.ValueInt64(),
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		var err error
+		ip, err = d.client.GetPublicIP(ctx, data.ID.ValueInt64(), opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Public IP", err)
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+		address := data.IP.ValueString()
+
+		tflog.Debug(ctx, "Looking up public IP", map[string]any{
+			"name":       name,
+			"ip":         address,
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		ips, err := d.client.GetPublicIPs(ctx, &client.ListFilter{Name: name}, opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Public IPs", err)
+			return
+		}
+
+		attr, criteria := path.Root("name"), fmt.Sprintf("name %q", name)
+		match := func(p client.PublicIP) bool { return p.Name == name }
+		if !data.IP.IsNull() {
+			attr, criteria = path.Root("ip"), fmt.Sprintf("address %s", address)
+			match = func(p client.PublicIP) bool { return p.IP == address }
+		}
+
+		found, ok := diagutil.SelectOne(&resp.Diagnostics, attr, diagutil.LookupKind{Title: "Public IP", Noun: "public IP"}, criteria, ips,
+			func(p client.PublicIP) int64 { return p.ID }, match)
+		if !ok {
+			return
+		}
+		ip = &found
 	}
 
+	data.ID = types.Int64Value(ip.ID)
 	data.Name = types.StringValue(ip.Name)
 	data.IP = types.StringValue(ip.IP)
 	data.Mask = types.StringValue(ip.Mask)
 	data.Gateway = types.StringValue(ip.Gateway)
+	data.AttachedInstanceID = types.Int64PointerValue(ip.AttachedInstanceID)
+	data.Tags = tagsValue(ip.Tags)
 
 	tflog.Debug(ctx, "Successfully read public IP", map[string]any{
-		"id":   ipID,
+		"id":   ip.ID,
 		"name": ip.Name,
 		"ip":   ip.IP,
 	})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,19 +23,28 @@ type PublicIPsDataSource struct {
 }
 
 type PublicIPsDataSourceModel struct {
-	Region    types.String    `tfsdk:"region"`
-	ProjectID types.Int64     `tfsdk:"project_id"`
-	PublicIPs []PublicIPModel `tfsdk:"public_ips"`
+	Region     types.String    `tfsdk:"region"`
+	ProjectID  types.Int64     `tfsdk:"project_id"`
+	Filter     types.Object    `tfsdk:"filter"`
+	SortBy     types.String    `tfsdk:"sort_by"`
+	MostRecent types.Bool      `tfsdk:"most_recent"`
+	PublicIPs  []PublicIPModel `tfsdk:"public_ips"`
 }
 
 type PublicIPModel struct {
-	ID      types.Int64  `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	IP      types.String `tfsdk:"ip"`
-	Mask    types.String `tfsdk:"mask"`
-	Gateway types.String `tfsdk:"gateway"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	IP                 types.String `tfsdk:"ip"`
+	Mask               types.String `tfsdk:"mask"`
+	Gateway            types.String `tfsdk:"gateway"`
+	AttachedInstanceID types.Int64  `tfsdk:"attached_instance_id"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Tags               types.Map    `tfsdk:"tags"`
 }
 
+// publicIPFilterFields are the filter block fields supported by prodata_public_ips.
+var publicIPFilterFields = []string{filterName, filterNameRegex, filterAttached, filterTags}
+
 func NewPublicIPsDataSource() datasource.DataSource {
 	return &PublicIPsDataSource{}
 }
@@ -44,6 +54,8 @@ func (d *PublicIPsDataSource) Metadata(ctx context.Context, req datasource.Metad
 }
 
 func (d *PublicIPsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sortBy, mostRecent := sortAttributes("public IPs")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List all available ProData public IPs.",
 
@@ -56,6 +68,8 @@ func (d *PublicIPsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
 				Optional:            true,
 			},
+			"sort_by":     sortBy,
+			"most_recent": mostRecent,
 			"public_ips": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available public IPs.",
 				Computed:            true,
@@ -81,10 +95,23 @@ func (d *PublicIPsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							MarkdownDescription: "The gateway IP address.",
 							Computed:            true,
 						},
+						"attached_instance_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the instance the address is bound to (if any).",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the public IP was created (RFC 3339).",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("public IP"),
 					},
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("public IPs", publicIPFilterFields...),
+		},
 	}
 }
 
@@ -126,20 +153,34 @@ func (d *PublicIPsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		"project_id": opts.ProjectID,
 	})
 
-	ips, err := d.client.GetPublicIPs(ctx, opts)
+	filter, diags := readFilter(ctx, req.Config, publicIPFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, err := d.client.GetPublicIPs(ctx, filter.apiFilter(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to List Public IPs", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Public IPs", err)
+		return
+	}
+
+	ips = applyListOptions(&resp.Diagnostics, filter, data.SortBy, data.MostRecent, ips, publicIPFilterItem)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.PublicIPs = make([]PublicIPModel, len(ips))
 	for i, ip := range ips {
 		data.PublicIPs[i] = PublicIPModel{
-			ID:      types.Int64Value(ip.ID),
-			Name:    types.StringValue(ip.Name),
-			IP:      types.StringValue(ip.IP),
-			Mask:    types.StringValue(ip.Mask),
-			Gateway: types.StringValue(ip.Gateway),
+			ID:                 types.Int64Value(ip.ID),
+			Name:               types.StringValue(ip.Name),
+			IP:                 types.StringValue(ip.IP),
+			Mask:               types.StringValue(ip.Mask),
+			Gateway:            types.StringValue(ip.Gateway),
+			AttachedInstanceID: types.Int64PointerValue(ip.AttachedInstanceID),
+			CreatedAt:          types.StringValue(ip.CreatedAt),
+			Tags:               tagsValue(ip.Tags),
 		}
 	}
 
@@ -149,3 +190,7 @@ func (d *PublicIPsDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func publicIPFilterItem(ip client.PublicIP) filterItem {
+	return filterItem{ID: ip.ID, Name: ip.Name, Attached: ip.AttachedInstanceID != nil, Tags: ip.Tags, CreatedAt: ip.CreatedAt}
+}
@@ -0,0 +1,130 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &RegionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &RegionsDataSource{}
+)
+
+type RegionsDataSource struct {
+	client *client.Client
+}
+
+type RegionsDataSourceModel struct {
+	Regions []RegionModel `tfsdk:"regions"`
+}
+
+type RegionModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	BaseURL  types.String   `tfsdk:"base_url"`
+	Services []types.String `tfsdk:"services"`
+}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the ProData regions available to the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "List of regions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The region ID used in the `region` attribute of the provider and resources (e.g., UZ-5).",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the region.",
+							Computed:            true,
+						},
+						"base_url": schema.StringAttribute{
+							MarkdownDescription: "The base URL of the region's API.",
+							Computed:            true,
+						},
+						"services": schema.ListAttribute{
+							MarkdownDescription: "The services available in the region (e.g., volumes, instances).",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing regions")
+
+	regions, err := d.client.GetRegions(ctx)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Regions", err)
+		return
+	}
+
+	data.Regions = make([]RegionModel, len(regions))
+	for i, region := range regions {
+		m := RegionModel{
+			ID:       types.StringValue(region.ID),
+			Name:     types.StringValue(region.Name),
+			BaseURL:  types.StringValue(region.BaseURL),
+			Services: make([]types.String, len(region.Services)),
+		}
+		for j, service := range region.Services {
+			m.Services[j] = types.StringValue(service)
+		}
+		data.Regions[i] = m
+	}
+
+	tflog.Debug(ctx, "Successfully listed regions", map[string]any{
+		"count": len(regions),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,151 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &SSHKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &SSHKeysDataSource{}
+)
+
+type SSHKeysDataSource struct {
+	client *client.Client
+}
+
+type SSHKeysDataSourceModel struct {
+	Filter  types.Object  `tfsdk:"filter"`
+	SSHKeys []SSHKeyModel `tfsdk:"ssh_keys"`
+}
+
+type SSHKeyModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+// sshKeyFilterFields are the filter block fields supported by prodata_ssh_keys.
+var sshKeyFilterFields = []string{filterTags}
+
+func NewSSHKeysDataSource() datasource.DataSource {
+	return &SSHKeysDataSource{}
+}
+
+func (d *SSHKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_keys"
+}
+
+func (d *SSHKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all ProData SSH keys, including keys uploaded through the console.",
+
+		Attributes: map[string]schema.Attribute{
+			"ssh_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "List of SSH keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the SSH key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the SSH key.",
+							Computed:            true,
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The public key in OpenSSH format.",
+							Computed:            true,
+						},
+						"fingerprint": schema.StringAttribute{
+							MarkdownDescription: "The fingerprint of the public key.",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("SSH key"),
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("SSH keys", sshKeyFilterFields...),
+		},
+	}
+}
+
+func (d *SSHKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *SSHKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSHKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing SSH keys")
+
+	filter, diags := readFilter(ctx, req.Config, sshKeyFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.GetSSHKeys(ctx, nil)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List SSH Keys", err)
+		return
+	}
+
+	keys = filterItems(&resp.Diagnostics, filter, keys, sshKeyFilterItem)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SSHKeys = make([]SSHKeyModel, len(keys))
+	for i, key := range keys {
+		data.SSHKeys[i] = SSHKeyModel{
+			ID:          types.Int64Value(key.ID),
+			Name:        types.StringValue(key.Name),
+			PublicKey:   types.StringValue(key.PublicKey),
+			Fingerprint: types.StringValue(key.Fingerprint),
+			Tags:        tagsValue(key.Tags),
+		}
+	}
+
+	tflog.Debug(ctx, "Successfully listed SSH keys", map[string]any{
+		"count": len(keys),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func sshKeyFilterItem(k client.SSHKey) filterItem {
+	return filterItem{ID: k.ID, Name: k.Name, Tags: k.Tags}
+}
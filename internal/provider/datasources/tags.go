@@ -0,0 +1,26 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsAttribute returns the computed `tags` attribute. noun is the singular of the
+// described object (e.g. "volume").
+func tagsAttribute(noun string) schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "The tags assigned to the " + noun + ".",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// tagsValue converts tags to a map value. A nil map becomes an empty map.
+func tagsValue(tags map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(tags))
+	for k, v := range tags {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
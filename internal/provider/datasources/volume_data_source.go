@@ -5,16 +5,20 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ datasource.DataSource              = &VolumeDataSource{}
-	_ datasource.DataSourceWithConfigure = &VolumeDataSource{}
+	_ datasource.DataSource                     = &VolumeDataSource{}
+	_ datasource.DataSourceWithConfigure        = &VolumeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &VolumeDataSource{}
 )
 
 type VolumeDataSource struct {
@@ -30,6 +34,7 @@ type VolumeDataSourceModel struct {
 	Size       types.Int64  `tfsdk:"size"`
 	InUse      types.Bool   `tfsdk:"in_use"`
 	AttachedID types.Int64  `tfsdk:"attached_id"`
+	Tags       types.Map    `tfsdk:"tags"`
 }
 
 func NewVolumeDataSource() datasource.DataSource {
@@ -42,12 +47,13 @@ func (d *VolumeDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *VolumeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Lookup a ProData volume by ID.",
+		MarkdownDescription: "Lookup a ProData volume by ID or name.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "The unique identifier of the volume.",
-				Required:            true,
+				MarkdownDescription: "The unique identifier of the volume. Conflicts with `name`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
@@ -58,7 +64,8 @@ func (d *VolumeDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the volume.",
+				MarkdownDescription: "The name of the volume. Exactly one volume must have this name. Conflicts with `id`.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"type": schema.StringAttribute{
@@ -77,10 +84,20 @@ func (d *VolumeDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "The ID of the instance the volume is attached to (if any).",
 				Computed:            true,
 			},
+			"tags": tagsAttribute("volume"),
 		},
 	}
 }
 
+func (d *VolumeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *VolumeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -114,24 +131,50 @@ func (d *VolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		opts.ProjectID = data.ProjectID.ValueInt64()
 	}
 
-	volumeID := data.ID.ValueInt64()
-
-	tflog.Debug(ctx, "Reading volume", map[string]any{
-		"id":         volumeID,
-		"region":     opts.Region,
-		"project_id": opts.ProjectID,
-	})
-
-	volume, err := d.client.GetVolume(ctx, volumeID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Volume", err.Error())
-		return
+	var volume *client.Volume
+	if !data.ID.IsNull() {
+		tflog.Debug(ctx, "Reading volume", map[string]any{
+			"id":         data.ID.ValueInt64(),
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		var err error
+		volume, err = d.client.GetVolume(ctx, data.ID.ValueInt64(), opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Volume", err)
+			return
+		}
+	} else {
+		name := data.Name.ValueString()
+
+		tflog.Debug(ctx, "Looking up volume by name", map[string]any{
+			"name":       name,
+			"region":     opts.Region,
+			"project_id": opts.ProjectID,
+		})
+
+		volumes, err := d.client.GetVolumes(ctx, &client.ListFilter{Name: name}, opts)
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Volumes", err)
+			return
+		}
+
+		found, ok := diagutil.SelectOne(&resp.Diagnostics, path.Root("name"), diagutil.LookupKind{Title: "Volume", Noun: "volume"}, fmt.Sprintf("name %q", name), volumes,
+			func(v client.Volume) int64 { return v.ID },
+			func(v client.Volume) bool { return v.Name == name })
+		if !ok {
+			return
+		}
+		volume = &found
 	}
 
+	data.ID = types.Int64Value(volume.ID)
 	data.Name = types.StringValue(volume.Name)
 	data.Type = types.StringValue(volume.Type)
 	data.Size = types.Int64Value(volume.Size)
 	data.InUse = types.BoolValue(volume.InUse)
+	data.Tags = tagsValue(volume.Tags)
 
 	if volume.AttachedID != nil {
 		data.AttachedID = types.Int64Value(*volume.AttachedID)
@@ -140,7 +183,7 @@ func (d *VolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	tflog.Debug(ctx, "Successfully read volume", map[string]any{
-		"id":   volumeID,
+		"id":   volume.ID,
 		"name": volume.Name,
 	})
 
@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &VolumeSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &VolumeSnapshotDataSource{}
+)
+
+type VolumeSnapshotDataSource struct {
+	client *client.Client
+}
+
+type VolumeSnapshotDataSourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Region    types.String `tfsdk:"region"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+	Size      types.Int64  `tfsdk:"size"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+func NewVolumeSnapshotDataSource() datasource.DataSource {
+	return &VolumeSnapshotDataSource{}
+}
+
+func (d *VolumeSnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot"
+}
+
+func (d *VolumeSnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a ProData volume snapshot by ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the snapshot.",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the snapshot.",
+				Computed:            true,
+			},
+			"volume_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the volume the snapshot was taken from.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the snapshot in GB.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the snapshot.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the snapshot was taken, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"tags": tagsAttribute("snapshot"),
+		},
+	}
+}
+
+func (d *VolumeSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *VolumeSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeSnapshotDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	snapshotID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading volume snapshot", map[string]any{
+		"id":         snapshotID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	snapshot, err := d.client.GetSnapshot(ctx, snapshotID, opts)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Volume Snapshot", err)
+		return
+	}
+
+	data.Name = types.StringValue(snapshot.Name)
+	data.VolumeID = types.Int64Value(snapshot.VolumeID)
+	data.Size = types.Int64Value(snapshot.Size)
+	data.Status = types.StringValue(snapshot.Status)
+	data.CreatedAt = types.StringValue(snapshot.CreatedAt)
+	data.Tags = tagsValue(snapshot.Tags)
+
+	tflog.Debug(ctx, "Successfully read volume snapshot", map[string]any{
+		"id":   snapshotID,
+		"name": snapshot.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,192 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &VolumeSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &VolumeSnapshotsDataSource{}
+)
+
+type VolumeSnapshotsDataSource struct {
+	client *client.Client
+}
+
+type VolumeSnapshotsDataSourceModel struct {
+	Region    types.String          `tfsdk:"region"`
+	ProjectID types.Int64           `tfsdk:"project_id"`
+	VolumeID  types.Int64           `tfsdk:"volume_id"`
+	Filter    types.Object          `tfsdk:"filter"`
+	Snapshots []VolumeSnapshotModel `tfsdk:"snapshots"`
+}
+
+type VolumeSnapshotModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+	Size      types.Int64  `tfsdk:"size"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
+}
+
+// snapshotFilterFields are the filter block fields supported by prodata_volume_snapshots.
+var snapshotFilterFields = []string{filterTags}
+
+func NewVolumeSnapshotsDataSource() datasource.DataSource {
+	return &VolumeSnapshotsDataSource{}
+}
+
+func (d *VolumeSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshots"
+}
+
+func (d *VolumeSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List ProData volume snapshots, optionally limited to a single volume.",
+
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
+				Optional:            true,
+			},
+			"volume_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return snapshots of this volume.",
+				Optional:            true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "List of snapshots.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The unique identifier of the snapshot.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the snapshot.",
+							Computed:            true,
+						},
+						"volume_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the volume the snapshot was taken from.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the snapshot in GB.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the snapshot.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The time the snapshot was taken, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("snapshot"),
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("snapshots", snapshotFilterFields...),
+		},
+	}
+}
+
+func (d *VolumeSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *VolumeSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeSnapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	volumeID := data.VolumeID.ValueInt64()
+
+	tflog.Debug(ctx, "Listing volume snapshots", map[string]any{
+		"volume_id":  volumeID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	filter, diags := readFilter(ctx, req.Config, snapshotFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := d.client.GetSnapshots(ctx, volumeID, opts)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Volume Snapshots", err)
+		return
+	}
+
+	snapshots = filterItems(&resp.Diagnostics, filter, snapshots, snapshotFilterItem)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Snapshots = make([]VolumeSnapshotModel, len(snapshots))
+	for i, s := range snapshots {
+		data.Snapshots[i] = VolumeSnapshotModel{
+			ID:        types.Int64Value(s.ID),
+			Name:      types.StringValue(s.Name),
+			VolumeID:  types.Int64Value(s.VolumeID),
+			Size:      types.Int64Value(s.Size),
+			Status:    types.StringValue(s.Status),
+			CreatedAt: types.StringValue(s.CreatedAt),
+			Tags:      tagsValue(s.Tags),
+		}
+	}
+
+	tflog.Debug(ctx, "Successfully listed volume snapshots", map[string]any{
+		"count": len(snapshots),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func snapshotFilterItem(s client.Snapshot) filterItem {
+	return filterItem{ID: s.ID, Name: s.Name, Tags: s.Tags, CreatedAt: s.CreatedAt}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,9 +23,12 @@ type VolumesDataSource struct {
 }
 
 type VolumesDataSourceModel struct {
-	Region    types.String  `tfsdk:"region"`
-	ProjectID types.Int64   `tfsdk:"project_id"`
-	Volumes   []VolumeModel `tfsdk:"volumes"`
+	Region     types.String  `tfsdk:"region"`
+	ProjectID  types.Int64   `tfsdk:"project_id"`
+	Filter     types.Object  `tfsdk:"filter"`
+	SortBy     types.String  `tfsdk:"sort_by"`
+	MostRecent types.Bool    `tfsdk:"most_recent"`
+	Volumes    []VolumeModel `tfsdk:"volumes"`
 }
 
 type VolumeModel struct {
@@ -34,8 +38,13 @@ type VolumeModel struct {
 	Size       types.Int64  `tfsdk:"size"`
 	InUse      types.Bool   `tfsdk:"in_use"`
 	AttachedID types.Int64  `tfsdk:"attached_id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Tags       types.Map    `tfsdk:"tags"`
 }
 
+// volumeFilterFields are the filter block fields supported by prodata_volumes.
+var volumeFilterFields = []string{filterName, filterNameRegex, filterType, filterInUse, filterAttached, filterTags}
+
 func NewVolumesDataSource() datasource.DataSource {
 	return &VolumesDataSource{}
 }
@@ -45,6 +54,8 @@ func (d *VolumesDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 func (d *VolumesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sortBy, mostRecent := sortAttributes("volumes")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List all available ProData volumes.",
 
@@ -57,6 +68,8 @@ func (d *VolumesDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project id.",
 				Optional:            true,
 			},
+			"sort_by":     sortBy,
+			"most_recent": mostRecent,
 			"volumes": schema.ListNestedAttribute{
 				MarkdownDescription: "List of available volumes.",
 				Computed:            true,
@@ -86,10 +99,19 @@ func (d *VolumesDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "The ID of the instance the volume is attached to (if any).",
 							Computed:            true,
 						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the volume was created (RFC 3339).",
+							Computed:            true,
+						},
+						"tags": tagsAttribute("volume"),
 					},
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("volumes", volumeFilterFields...),
+		},
 	}
 }
 
@@ -131,20 +153,33 @@ func (d *VolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		"project_id": opts.ProjectID,
 	})
 
-	volumes, err := d.client.GetVolumes(ctx, opts)
+	filter, diags := readFilter(ctx, req.Config, volumeFilterFields...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumes, err := d.client.GetVolumes(ctx, filter.apiFilter(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to List Volumes", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to List Volumes", err)
+		return
+	}
+
+	volumes = applyListOptions(&resp.Diagnostics, filter, data.SortBy, data.MostRecent, volumes, volumeFilterItem)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Volumes = make([]VolumeModel, len(volumes))
 	for i, vol := range volumes {
 		data.Volumes[i] = VolumeModel{
-			ID:    types.Int64Value(vol.ID),
-			Name:  types.StringValue(vol.Name),
-			Type:  types.StringValue(vol.Type),
-			Size:  types.Int64Value(vol.Size),
-			InUse: types.BoolValue(vol.InUse),
+			ID:        types.Int64Value(vol.ID),
+			Name:      types.StringValue(vol.Name),
+			Type:      types.StringValue(vol.Type),
+			Size:      types.Int64Value(vol.Size),
+			InUse:     types.BoolValue(vol.InUse),
+			CreatedAt: types.StringValue(vol.CreatedAt),
+			Tags:      tagsValue(vol.Tags),
 		}
 		if vol.AttachedID != nil {
 			data.Volumes[i].AttachedID = types.Int64Value(*vol.AttachedID)
@@ -159,3 +194,7 @@ func (d *VolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func volumeFilterItem(v client.Volume) filterItem {
+	return filterItem{ID: v.ID, Name: v.Name, Type: v.Type, InUse: v.InUse, Attached: v.AttachedID != nil, Tags: v.Tags, CreatedAt: v.CreatedAt}
+}
@@ -0,0 +1,31 @@
+// Package diagutil converts client errors and failed lookups into Terraform
+// diagnostics.
+package diagutil
+
+import (
+	"terraform-provider-prodata/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AddAPIError appends an error diagnostic for err, adding guidance for
+// well-known API failures such as rejected credentials or exhausted quotas.
+func AddAPIError(diags *diag.Diagnostics, summary string, err error) {
+	detail := err.Error()
+
+	switch {
+	case client.IsUnauthorized(err):
+		detail += "\n\nThe API rejected the credentials. Check api_key_id and api_secret_key " +
+			"(or PRODATA_API_KEY_ID and PRODATA_API_SECRET_KEY) and the key's access to the project."
+	case client.IsQuotaExceeded(err):
+		detail += "\n\nThe project quota has been exceeded. Release unused resources " +
+			"or request a quota increase from ProData support."
+	case client.IsConflict(err):
+		detail += "\n\nThe request conflicts with the current state of the object, " +
+			"for example because it is in use or another operation is in progress."
+	case client.IsNotFound(err):
+		detail += "\n\nThe object does not exist or is not visible in the selected region and project."
+	}
+
+	diags.AddError(summary, detail)
+}
@@ -0,0 +1,70 @@
+package diagutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// LookupKind names the object being looked up, for use in diagnostics.
+type LookupKind struct {
+	Title string // e.g. "Volume"
+	Noun  string // e.g. "volume"
+	// Account is set for objects that belong to the account rather than to a
+	// region and project, such as projects themselves.
+	Account bool
+	// Selector is the attribute that selects one of several matches by ID.
+	// Defaults to id.
+	Selector string
+}
+
+// SelectOne returns the only item for which match reports true. When no item or
+// several items match, it adds an error on attr and returns false; criteria
+// describes what was searched for, such as `name "backups"`. An empty attr adds
+// the error without a path, for values that may come from the environment.
+func SelectOne[T any](diags *diag.Diagnostics, attr path.Path, kind LookupKind, criteria string, items []T, id func(T) int64, match func(T) bool) (T, bool) {
+	var found []T
+	for _, item := range items {
+		if match(item) {
+			found = append(found, item)
+		}
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0], true
+	case 0:
+		hint := "Check the value, region and project_id."
+		if kind.Account {
+			hint = "Check the value."
+		}
+		addError(diags, attr, kind.Title+" Not Found",
+			fmt.Sprintf("No %s with %s was found. %s", kind.Noun, criteria, hint))
+	default:
+		ids := make([]string, len(found))
+		for i, item := range found {
+			ids[i] = strconv.FormatInt(id(item), 10)
+		}
+		selector := kind.Selector
+		if selector == "" {
+			selector = "id"
+		}
+		addError(diags, attr, "Multiple "+kind.Title+"s Found",
+			fmt.Sprintf("%d %ss with %s were found (IDs %s). Use %s to select one of them.",
+				len(found), kind.Noun, criteria, strings.Join(ids, ", "), selector))
+	}
+
+	var zero T
+	return zero, false
+}
+
+func addError(diags *diag.Diagnostics, attr path.Path, summary, detail string) {
+	if attr.Equal(path.Empty()) {
+		diags.AddError(summary, detail)
+		return
+	}
+	diags.AddAttributeError(attr, summary, detail)
+}
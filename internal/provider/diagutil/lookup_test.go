@@ -0,0 +1,97 @@
+package diagutil
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+type item struct {
+	ID   int64
+	Name string
+}
+
+func TestSelectOne(t *testing.T) {
+	items := []item{{ID: 1, Name: "web"}, {ID: 2, Name: "db"}, {ID: 3, Name: "db"}}
+
+	tests := []struct {
+		name       string
+		attr       path.Path
+		kind       LookupKind
+		lookup     string
+		wantID     int64
+		wantErr    string
+		wantDetail string
+	}{
+		{
+			name:   "single match",
+			attr:   path.Root("name"),
+			kind:   LookupKind{Title: "Volume", Noun: "volume"},
+			lookup: "web",
+			wantID: 1,
+		},
+		{
+			name:       "no match",
+			attr:       path.Root("name"),
+			kind:       LookupKind{Title: "Volume", Noun: "volume"},
+			lookup:     "cache",
+			wantErr:    "Volume Not Found",
+			wantDetail: `No volume with name "cache" was found. Check the value, region and project_id.`,
+		},
+		{
+			name:       "no match for an account object",
+			kind:       LookupKind{Title: "Project", Noun: "project", Account: true},
+			lookup:     "cache",
+			wantErr:    "Project Not Found",
+			wantDetail: `No project with name "cache" was found. Check the value.`,
+		},
+		{
+			name:       "several matches",
+			attr:       path.Root("name"),
+			kind:       LookupKind{Title: "Volume", Noun: "volume"},
+			lookup:     "db",
+			wantErr:    "Multiple Volumes Found",
+			wantDetail: `2 volumes with name "db" were found (IDs 2, 3). Use id to select one of them.`,
+		},
+		{
+			name:       "several matches with a selector",
+			kind:       LookupKind{Title: "Project", Noun: "project", Account: true, Selector: "project_id"},
+			lookup:     "db",
+			wantErr:    "Multiple Projects Found",
+			wantDetail: `2 projects with name "db" were found (IDs 2, 3). Use project_id to select one of them.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := SelectOne(&diags, tt.attr, tt.kind, `name "`+tt.lookup+`"`, items,
+				func(i item) int64 { return i.ID },
+				func(i item) bool { return i.Name == tt.lookup })
+
+			if tt.wantErr == "" {
+				if !ok || diags.HasError() || got.ID != tt.wantID {
+					t.Fatalf("SelectOne() = %+v, %v, %v, want ID %d", got, ok, diags, tt.wantID)
+				}
+				return
+			}
+
+			if ok || len(diags) != 1 {
+				t.Fatalf("SelectOne() = %+v, %v, %v, want one error", got, ok, diags)
+			}
+			d := diags[0]
+			if d.Summary() != tt.wantErr || d.Detail() != tt.wantDetail {
+				t.Errorf("error = %q: %q, want %q: %q", d.Summary(), d.Detail(), tt.wantErr, tt.wantDetail)
+			}
+			wp, hasPath := d.(diag.DiagnosticWithPath)
+			if tt.attr.Equal(path.Empty()) {
+				if hasPath {
+					t.Errorf("error is tied to %s, want no attribute", wp.Path())
+				}
+			} else if !hasPath || !wp.Path().Equal(tt.attr) {
+				t.Errorf("error is not tied to %s", tt.attr)
+			}
+		})
+	}
+}
@@ -5,17 +5,27 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"terraform-provider-prodata/internal/client"
 	"terraform-provider-prodata/internal/provider/datasources"
+	"terraform-provider-prodata/internal/provider/diagutil"
 	"terraform-provider-prodata/internal/provider/resources"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &ProDataProvider{}
@@ -30,6 +40,19 @@ type ProDataProviderModel struct {
 	APISecretKey types.String `tfsdk:"api_secret_key"`
 	Region       types.String `tfsdk:"region"`
 	ProjectID    types.Int64  `tfsdk:"project_id"`
+	ProjectName  types.String `tfsdk:"project_name"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64  `tfsdk:"retry_wait_max"`
+
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+
+	DefaultTags *DefaultTagsModel `tfsdk:"default_tags"`
+}
+
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
 }
 
 func New(version string) func() provider.Provider {
@@ -65,7 +88,8 @@ func (p *ProDataProvider) Schema(ctx context.Context, req provider.SchemaRequest
 			},
 			"region": schema.StringAttribute{
 				MarkdownDescription: "Default region ID (e.g., `UZ-5`, `UZ-3`, `KZ-1`). " +
-					"Can also be set via `PRODATA_REGION` environment variable.",
+					"Can also be set via `PRODATA_REGION` environment variable. " +
+					"The provider warns when the region is not one of the regions listed by the `prodata_regions` data source.",
 				Optional: true,
 			},
 			"project_id": schema.Int64Attribute{
@@ -73,6 +97,76 @@ func (p *ProDataProvider) Schema(ctx context.Context, req provider.SchemaRequest
 					"Can also be set via `PRODATA_PROJECT_ID` environment variable.",
 				Optional: true,
 			},
+			"project_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the default project, as an alternative to `project_id`. " +
+					"The name is resolved to an ID when the provider is configured and must match exactly one project. " +
+					"Conflicts with `project_id`. " +
+					"Can also be set via `PRODATA_PROJECT_NAME` environment variable, which is only used when no project ID is set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("project_id")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for requests failing with transient errors " +
+					"(HTTP 429, 502, 503, 504 or connection errors). Set to `0` to disable retries. Defaults to `3`. " +
+					"Can also be set via `PRODATA_MAX_RETRIES` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying a request. Defaults to `1`. " +
+					"Can also be set via `PRODATA_RETRY_WAIT_MIN` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying a request. Defaults to `30`. " +
+					"A shorter `Retry-After` header returned by the API takes precedence; longer ones are capped at this value. " +
+					"Can also be set via `PRODATA_RETRY_WAIT_MAX` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second shared by all resources and data sources. " +
+					"Set to `0` to disable the limit. Defaults to `10`. " +
+					"Can also be set via `PRODATA_REQUESTS_PER_SECOND` environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests that may be sent at once before `requests_per_second` applies. " +
+					"Defaults to `10`. Can also be set via `PRODATA_BURST` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags applied to every resource that supports tags. " +
+					"Tags set on a resource take precedence over default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Tags to apply to every resource.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -112,16 +206,49 @@ func (p *ProDataProvider) Configure(ctx context.Context, req provider.ConfigureR
 		cfg.Region = os.Getenv("PRODATA_REGION")
 	}
 
-	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+	// A project set in config takes precedence over both environment variables, and an
+	// ID over a name.
+	projectName := ""
+	switch {
+	case !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown():
 		cfg.ProjectID = data.ProjectID.ValueInt64()
-	} else if env := os.Getenv("PRODATA_PROJECT_ID"); env != "" {
-		if v, err := strconv.ParseInt(env, 10, 64); err != nil {
-			resp.Diagnostics.AddWarning(
-				"Invalid PRODATA_PROJECT_ID",
-				fmt.Sprintf("Could not parse %q as integer: %s", env, err),
-			)
-		} else {
+	case !data.ProjectName.IsNull() && !data.ProjectName.IsUnknown():
+		projectName = data.ProjectName.ValueString()
+	default:
+		if v, ok := int64Setting(data.ProjectID, "PRODATA_PROJECT_ID", &resp.Diagnostics); ok {
 			cfg.ProjectID = v
+		} else {
+			projectName = os.Getenv("PRODATA_PROJECT_NAME")
+		}
+	}
+
+	cfg.MaxRetries = client.DefaultMaxRetries
+	if v, ok := int64Setting(data.MaxRetries, "PRODATA_MAX_RETRIES", &resp.Diagnostics); ok {
+		cfg.MaxRetries = int(v)
+	}
+	if v, ok := int64Setting(data.RetryWaitMin, "PRODATA_RETRY_WAIT_MIN", &resp.Diagnostics); ok {
+		cfg.RetryWaitMin = time.Duration(v) * time.Second
+	}
+	if v, ok := int64Setting(data.RetryWaitMax, "PRODATA_RETRY_WAIT_MAX", &resp.Diagnostics); ok {
+		cfg.RetryWaitMax = time.Duration(v) * time.Second
+	}
+
+	cfg.RequestsPerSecond = client.DefaultRequestsPerSecond
+	if v, ok := float64Setting(data.RequestsPerSecond, "PRODATA_REQUESTS_PER_SECOND", &resp.Diagnostics); ok {
+		cfg.RequestsPerSecond = v
+	}
+	cfg.Burst = client.DefaultBurst
+	if v, ok := int64Setting(data.Burst, "PRODATA_BURST", &resp.Diagnostics); ok {
+		cfg.Burst = int(v)
+	}
+
+	if data.DefaultTags != nil && !data.DefaultTags.Tags.IsNull() {
+		// Resources plan tags_all from the default tags, so they must be known.
+		if data.DefaultTags.Tags.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root("default_tags").AtName("tags"), "Unknown Default Tags",
+				"default_tags must be known when the provider is configured. Use values that do not depend on other resources.")
+		} else {
+			resp.Diagnostics.Append(data.DefaultTags.Tags.ElementsAs(ctx, &cfg.DefaultTags, false)...)
 		}
 	}
 
@@ -150,15 +277,139 @@ func (p *ProDataProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	if projectName != "" {
+		resolveProject(ctx, c, projectName, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if cfg.Region != "" {
+		checkRegion(ctx, c, cfg.Region, &resp.Diagnostics)
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
 }
 
+// checkRegion warns when region is not one of the regions reported by the API. A
+// failure to list regions is only logged, since it must not block configuration.
+func checkRegion(ctx context.Context, c *client.Client, region string, diags *diag.Diagnostics) {
+	regions, err := c.GetRegions(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to list regions, skipping region validation", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ids := make([]string, len(regions))
+	for i, r := range regions {
+		if r.ID == region {
+			return
+		}
+		ids[i] = r.ID
+	}
+
+	// The region may come from PRODATA_REGION, so the warning is not tied to the attribute.
+	diags.AddWarning(
+		"Unknown Region",
+		fmt.Sprintf("Region %q is not one of the regions available to this account (%s). "+
+			"Requests that use the default region are likely to fail. "+
+			"Use the prodata_regions data source to list the available regions.", region, strings.Join(ids, ", ")),
+	)
+}
+
+// resolveProject sets the client's default project to the only project named name.
+func resolveProject(ctx context.Context, c *client.Client, name string, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "Resolving project name", map[string]any{
+		"project_name": name,
+	})
+
+	projects, err := c.GetProjects(ctx, &client.ListFilter{Name: name})
+	if err != nil {
+		diagutil.AddAPIError(diags, "Unable to Resolve Project Name", err)
+		return
+	}
+
+	// The name may come from PRODATA_PROJECT_NAME, so errors are not tied to the attribute.
+	project, ok := diagutil.SelectOne(diags, path.Empty(), diagutil.LookupKind{Title: "Project", Noun: "project", Account: true, Selector: "project_id"},
+		fmt.Sprintf("name %q", name), projects,
+		func(p client.Project) int64 { return p.ID },
+		func(p client.Project) bool { return p.Name == name })
+	if !ok {
+		return
+	}
+
+	c.ProjectID = project.ID
+	tflog.Debug(ctx, "Resolved project name", map[string]any{
+		"project_name": name,
+		"project_id":   c.ProjectID,
+	})
+}
+
+// int64Setting returns the configured value, falling back to the named environment variable.
+// ok is false when neither is set or the environment variable is not a valid integer.
+func int64Setting(v types.Int64, env string, diags *diag.Diagnostics) (int64, bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueInt64(), true
+	}
+
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		diags.AddWarning(
+			"Invalid "+env,
+			fmt.Sprintf("Could not parse %q as integer: %s", s, err),
+		)
+		return 0, false
+	}
+	return parsed, true
+}
+
+// float64Setting returns the configured value, falling back to the named environment variable.
+// ok is false when neither is set or the environment variable is not a valid number.
+func float64Setting(v types.Float64, env string, diags *diag.Diagnostics) (float64, bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		return v.ValueFloat64(), true
+	}
+
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		diags.AddWarning(
+			"Invalid "+env,
+			fmt.Sprintf("Could not parse %q as number: %s", s, err),
+		)
+		return 0, false
+	}
+	return parsed, true
+}
+
 func (p *ProDataProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewVolumeResource,
 		resources.NewLocalNetworkResource,
 		resources.NewPublicIPResource,
+		resources.NewPublicIPAssociationResource,
+		resources.NewInstanceResource,
+		resources.NewVolumeAttachmentResource,
+		resources.NewLocalNetworkAttachmentResource,
+		resources.NewVolumeSnapshotResource,
+		resources.NewImageResource,
+		resources.NewSSHKeyResource,
+		resources.NewSecurityGroupResource,
+		resources.NewSecurityGroupRuleResource,
+		resources.NewSecurityGroupAssociationResource,
+		resources.NewProjectResource,
 	}
 }
 
@@ -168,9 +419,17 @@ func (p *ProDataProvider) DataSources(ctx context.Context) []func() datasource.D
 		datasources.NewImagesDataSource,
 		datasources.NewVolumeDataSource,
 		datasources.NewVolumesDataSource,
+		datasources.NewVolumeSnapshotDataSource,
+		datasources.NewVolumeSnapshotsDataSource,
 		datasources.NewLocalNetworkDataSource,
 		datasources.NewLocalNetworksDataSource,
 		datasources.NewPublicIPDataSource,
 		datasources.NewPublicIPsDataSource,
+		datasources.NewInstanceDataSource,
+		datasources.NewInstancesDataSource,
+		datasources.NewSSHKeysDataSource,
+		datasources.NewRegionsDataSource,
+		datasources.NewProjectDataSource,
+		datasources.NewProjectsDataSource,
 	}
 }
@@ -0,0 +1,450 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Capturing a disk into an image copies the whole disk.
+const defaultImageCreateTimeout = 30 * time.Minute
+
+var (
+	_ resource.Resource                     = &ImageResource{}
+	_ resource.ResourceWithConfigure        = &ImageResource{}
+	_ resource.ResourceWithModifyPlan       = &ImageResource{}
+	_ resource.ResourceWithConfigValidators = &ImageResource{}
+	_ resource.ResourceWithImportState      = &ImageResource{}
+)
+
+type ImageResource struct {
+	client *client.Client
+}
+
+type ImageResourceModel struct {
+	ID         types.Int64    `tfsdk:"id"`
+	Region     types.String   `tfsdk:"region"`
+	ProjectID  types.Int64    `tfsdk:"project_id"`
+	Name       types.String   `tfsdk:"name"`
+	InstanceID types.Int64    `tfsdk:"instance_id"`
+	SnapshotID types.Int64    `tfsdk:"snapshot_id"`
+	Slug       types.String   `tfsdk:"slug"`
+	Status     types.String   `tfsdk:"status"`
+	Tags       types.Map      `tfsdk:"tags"`
+	TagsAll    types.Map      `tfsdk:"tags_all"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewImageResource() resource.Resource {
+	return &ImageResource{}
+}
+
+func (r *ImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (r *ImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("image")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ProData custom image created from an instance or a volume snapshot.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID where the image will be created. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the image. This and `tags` are the only attributes that can be updated in-place.",
+				Required:            true,
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance to capture. Conflicts with `snapshot_id`. Changing this forces a new resource.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64CreateOnlySource(path.Root("snapshot_id")),
+				},
+			},
+			"snapshot_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the volume snapshot to create the image from. Conflicts with `instance_id`. Changing this forces a new resource.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64CreateOnlySource(path.Root("instance_id")),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug assigned to the image, usable with the `prodata_image` data source.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the image (e.g., `active`).",
+				Computed:            true,
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ImageResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("instance_id"),
+			path.MatchRoot("snapshot_id"),
+		),
+	}
+}
+
+func (r *ImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags, and clears
+// the previous source when the configuration switches between an instance and a
+// snapshot. The source planned in its place forces the replacement.
+func (r *ImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	// UseStateForUnknown keeps the state value of a source that is no longer
+	// configured, which would otherwise be planned next to the new one.
+	for _, attrs := range [][2]string{{"instance_id", "snapshot_id"}, {"snapshot_id", "instance_id"}} {
+		var state, config, other types.Int64
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attrs[0]), &state)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attrs[0]), &config)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attrs[1]), &other)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !state.IsNull() && config.IsNull() && !other.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attrs[0]), types.Int64Null())...)
+		}
+	}
+}
+
+func (r *ImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultImageCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	createReq := client.CreateImageRequest{
+		Region:     region,
+		ProjectID:  projectID,
+		Name:       data.Name.ValueString(),
+		InstanceID: data.InstanceID.ValueInt64(),
+		SnapshotID: data.SnapshotID.ValueInt64(),
+		Tags:       tags,
+	}
+	data.InstanceID = nullIfUnknown(data.InstanceID)
+	data.SnapshotID = nullIfUnknown(data.SnapshotID)
+
+	tflog.Debug(ctx, "Creating image", map[string]any{
+		"name":        createReq.Name,
+		"instance_id": createReq.InstanceID,
+		"snapshot_id": createReq.SnapshotID,
+		"region":      createReq.Region,
+		"project_id":  createReq.ProjectID,
+	})
+
+	image, err := r.client.CreateImage(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Image", err)
+		return
+	}
+
+	data.ID = types.Int64Value(image.ID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+	data.Name = types.StringValue(image.Name)
+	data.Slug = types.StringValue(image.Slug)
+	data.Status = types.StringValue(image.Status)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err = r.client.WaitForImageReady(ctx, image.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Image", err)
+		return
+	}
+
+	data.Name = types.StringValue(image.Name)
+	data.Slug = types.StringValue(image.Slug)
+	data.Status = types.StringValue(image.Status)
+
+	tflog.Debug(ctx, "Created image", map[string]any{
+		"id":   image.ID,
+		"name": image.Name,
+		"slug": image.Slug,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	imageID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading image", map[string]any{
+		"id":         imageID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	image, err := r.client.GetImageByID(ctx, imageID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Image not found, removing from state", map[string]any{
+				"id": imageID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Image", err)
+		return
+	}
+
+	data.Name = types.StringValue(image.Name)
+	data.Slug = types.StringValue(image.Slug)
+	data.Status = types.StringValue(image.Status)
+	resp.Diagnostics.Append(readTags(ctx, r.client, image.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read image", map[string]any{
+		"id":   imageID,
+		"name": image.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ImageResourceModel
+	var state ImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imageID := state.ID.ValueInt64()
+
+	// Only name and tags can be updated via API, region and projectId in request body
+	updateReq := client.UpdateImageRequest{
+		Name: plan.Name.ValueString(),
+		Tags: tags,
+	}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		updateReq.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updateReq.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Updating image", map[string]any{
+		"id":         imageID,
+		"name":       updateReq.Name,
+		"region":     updateReq.Region,
+		"project_id": updateReq.ProjectID,
+	})
+
+	_, err := r.client.UpdateImage(ctx, imageID, updateReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Image", err)
+		return
+	}
+
+	image, err := r.client.WaitForImageReady(ctx, imageID, &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}, updateTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Image", err)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(image.Name)
+	plan.Slug = types.StringValue(image.Slug)
+	plan.Status = types.StringValue(image.Status)
+	plan.InstanceID = nullIfUnknown(plan.InstanceID)
+	plan.SnapshotID = nullIfUnknown(plan.SnapshotID)
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated image", map[string]any{
+		"id":   imageID,
+		"name": image.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	imageID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting image", map[string]any{
+		"id":         imageID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	err := r.client.DeleteImage(ctx, imageID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Image", err)
+		return
+	}
+
+	if err := r.client.WaitForImageDeleted(ctx, imageID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Image", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted image", map[string]any{
+		"id": imageID,
+	})
+}
+
+func (r *ImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
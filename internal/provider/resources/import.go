@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-prodata/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// importID is a parsed import identifier of a regional, project-scoped resource.
+type importID struct {
+	Region    string
+	ProjectID int64
+	ID        int64
+}
+
+// parseImportID accepts either a bare ID (`4567`) or a composite ID
+// in the form `<region>/<project_id>/<id>` (e.g., `UZ-5/123/4567`).
+func parseImportID(raw string) (importID, error) {
+	parts := strings.Split(raw, "/")
+
+	switch len(parts) {
+	case 1:
+		id, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return importID{}, fmt.Errorf("invalid ID %q: must be an integer", parts[0])
+		}
+		return importID{ID: id}, nil
+	case 3:
+		if parts[0] == "" {
+			return importID{}, fmt.Errorf("region must not be empty")
+		}
+		projectID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return importID{}, fmt.Errorf("invalid project ID %q: must be an integer", parts[1])
+		}
+		id, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return importID{}, fmt.Errorf("invalid ID %q: must be an integer", parts[2])
+		}
+		return importID{Region: parts[0], ProjectID: projectID, ID: id}, nil
+	default:
+		return importID{}, fmt.Errorf("expected <id> or <region>/<project_id>/<id>, got %q", raw)
+	}
+}
+
+// importRegionalResource sets id, region and project_id in state from the import
+// identifier, falling back to the provider defaults for a bare ID. The remaining
+// attributes are populated by the subsequent Read.
+func importRegionalResource(ctx context.Context, c *client.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsed, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	if parsed.Region == "" {
+		parsed.Region = c.Region
+	}
+	if parsed.ProjectID == 0 {
+		parsed.ProjectID = c.ProjectID
+	}
+
+	tflog.Debug(ctx, "Importing resource", map[string]any{
+		"id":         parsed.ID,
+		"region":     parsed.Region,
+		"project_id": parsed.ProjectID,
+	})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parsed.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), parsed.Region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parsed.ProjectID)...)
+}
+
+// pairImportID is a parsed import identifier of an object addressed by two IDs,
+// such as an attachment or a rule within a group.
+type pairImportID struct {
+	Region    string
+	ProjectID int64
+	First     int64
+	Second    int64
+}
+
+// parsePairImportID accepts `<first>/<second>` or `<region>/<project_id>/<first>/<second>`.
+// The names are used in error messages (e.g., "volume_id", "instance_id").
+func parsePairImportID(raw, firstName, secondName string) (pairImportID, error) {
+	parts := strings.Split(raw, "/")
+
+	var parsed pairImportID
+	switch len(parts) {
+	case 2:
+	case 4:
+		if parts[0] == "" {
+			return pairImportID{}, fmt.Errorf("region must not be empty")
+		}
+		projectID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return pairImportID{}, fmt.Errorf("invalid project ID %q: must be an integer", parts[1])
+		}
+		parsed.Region = parts[0]
+		parsed.ProjectID = projectID
+		parts = parts[2:]
+	default:
+		return pairImportID{}, fmt.Errorf("expected <%s>/<%s> or <region>/<project_id>/<%s>/<%s>, got %q",
+			firstName, secondName, firstName, secondName, raw)
+	}
+
+	first, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return pairImportID{}, fmt.Errorf("invalid %s %q: must be an integer", firstName, parts[0])
+	}
+	second, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return pairImportID{}, fmt.Errorf("invalid %s %q: must be an integer", secondName, parts[1])
+	}
+	parsed.First = first
+	parsed.Second = second
+
+	return parsed, nil
+}
+
+// importPairResource parses a pair import identifier and sets region and
+// project_id in state, falling back to the provider defaults. The caller sets
+// the remaining identifying attributes from the returned IDs.
+func importPairResource(ctx context.Context, c *client.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, firstName, secondName string) (pairImportID, bool) {
+	parsed, err := parsePairImportID(req.ID, firstName, secondName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return pairImportID{}, false
+	}
+
+	if parsed.Region == "" {
+		parsed.Region = c.Region
+	}
+	if parsed.ProjectID == 0 {
+		parsed.ProjectID = c.ProjectID
+	}
+
+	tflog.Debug(ctx, "Importing resource", map[string]any{
+		firstName:    parsed.First,
+		secondName:   parsed.Second,
+		"region":     parsed.Region,
+		"project_id": parsed.ProjectID,
+	})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), parsed.Region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parsed.ProjectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(firstName), parsed.First)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(secondName), parsed.Second)...)
+	return parsed, !resp.Diagnostics.HasError()
+}
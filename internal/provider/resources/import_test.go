@@ -0,0 +1,68 @@
+package resources
+
+import "testing"
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    importID
+		wantErr bool
+	}{
+		{raw: "4567", want: importID{ID: 4567}},
+		{raw: "UZ-5/123/4567", want: importID{Region: "UZ-5", ProjectID: 123, ID: 4567}},
+		{raw: "", wantErr: true},
+		{raw: "abc", wantErr: true},
+		{raw: "UZ-5/4567", wantErr: true},
+		{raw: "/123/4567", wantErr: true},
+		{raw: "UZ-5/abc/4567", wantErr: true},
+		{raw: "UZ-5/123/abc", wantErr: true},
+		{raw: "UZ-5/123/4567/1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parseImportID(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportID(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseImportID(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePairImportID(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    pairImportID
+		wantErr string
+	}{
+		{raw: "10/20", want: pairImportID{First: 10, Second: 20}},
+		{raw: "KZ-1/7/10/20", want: pairImportID{Region: "KZ-1", ProjectID: 7, First: 10, Second: 20}},
+		{raw: "10", wantErr: `expected <volume_id>/<instance_id> or <region>/<project_id>/<volume_id>/<instance_id>, got "10"`},
+		{raw: "10/20/30", wantErr: `expected <volume_id>/<instance_id> or <region>/<project_id>/<volume_id>/<instance_id>, got "10/20/30"`},
+		{raw: "x/20", wantErr: `invalid volume_id "x": must be an integer`},
+		{raw: "10/y", wantErr: `invalid instance_id "y": must be an integer`},
+		{raw: "/7/10/20", wantErr: "region must not be empty"},
+		{raw: "KZ-1/p/10/20", wantErr: `invalid project ID "p": must be an integer`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parsePairImportID(tt.raw, "volume_id", "instance_id")
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parsePairImportID(%q) error = %v, want %q", tt.raw, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePairImportID(%q) unexpected error: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("parsePairImportID(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,504 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Instances take longer to provision than other objects.
+const defaultInstanceCreateTimeout = 20 * time.Minute
+
+var (
+	_ resource.Resource                = &InstanceResource{}
+	_ resource.ResourceWithConfigure   = &InstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &InstanceResource{}
+	_ resource.ResourceWithImportState = &InstanceResource{}
+)
+
+type InstanceResource struct {
+	client *client.Client
+}
+
+type InstanceResourceModel struct {
+	ID              types.Int64    `tfsdk:"id"`
+	Region          types.String   `tfsdk:"region"`
+	ProjectID       types.Int64    `tfsdk:"project_id"`
+	Name            types.String   `tfsdk:"name"`
+	ImageID         types.Int64    `tfsdk:"image_id"`
+	CPU             types.Int64    `tfsdk:"cpu"`
+	RAM             types.Int64    `tfsdk:"ram"`
+	DiskSize        types.Int64    `tfsdk:"disk_size"`
+	DiskType        types.String   `tfsdk:"disk_type"`
+	SSHKeys         types.List     `tfsdk:"ssh_keys"`
+	LocalNetworkIDs types.Set      `tfsdk:"local_network_ids"`
+	PublicIPIDs     types.Set      `tfsdk:"public_ip_ids"`
+	UserData        types.String   `tfsdk:"user_data"`
+	Status          types.String   `tfsdk:"status"`
+	Tags            types.Map      `tfsdk:"tags"`
+	TagsAll         types.Map      `tfsdk:"tags_all"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewInstanceResource() resource.Resource {
+	return &InstanceResource{}
+}
+
+func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance"
+}
+
+func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("instance")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ProData virtual machine instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID where the instance will be created. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the instance.",
+				Required:            true,
+			},
+			"image_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the image (OS template or custom image) to boot from. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"cpu": schema.Int64Attribute{
+				MarkdownDescription: "The number of virtual CPU cores. Can be changed in place.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ram": schema.Int64Attribute{
+				MarkdownDescription: "The amount of memory in GB. Can be changed in place.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"disk_size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the boot disk in GB. The disk can be grown in place; shrinking it forces a new resource.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64RequiresReplaceIfDecreased(),
+				},
+			},
+			"disk_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the boot disk (HDD or SSD). Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_keys": schema.ListAttribute{
+				MarkdownDescription: "OpenSSH public keys to install for the default user. Only applied at creation; changing this forces a new resource.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listCreateOnly(),
+				},
+			},
+			"local_network_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of local networks to connect the instance to at creation. Changing this forces a new resource. " +
+					"Do not also connect these networks with `prodata_local_network_attachment`.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setCreateOnly(),
+				},
+			},
+			"public_ip_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of public IPs to bind to the instance at creation. Changing this forces a new resource. " +
+					"Do not also bind these addresses with `prodata_public_ip_association`.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setCreateOnly(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "Cloud-init user data passed to the instance on first boot. Changing this forces a new resource.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringCreateOnly(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the instance (e.g., `running`).",
+				Computed:            true,
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *InstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *InstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
+func (r *InstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultInstanceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	createReq := client.CreateInstanceRequest{
+		Region:    region,
+		ProjectID: projectID,
+		Name:      data.Name.ValueString(),
+		ImageID:   data.ImageID.ValueInt64(),
+		CPU:       data.CPU.ValueInt64(),
+		RAM:       data.RAM.ValueInt64(),
+		DiskSize:  data.DiskSize.ValueInt64(),
+		DiskType:  data.DiskType.ValueString(),
+		UserData:  data.UserData.ValueString(),
+		Tags:      tags,
+	}
+	resp.Diagnostics.Append(data.SSHKeys.ElementsAs(ctx, &createReq.SSHKeys, false)...)
+	resp.Diagnostics.Append(data.LocalNetworkIDs.ElementsAs(ctx, &createReq.LocalNetworkIDs, false)...)
+	resp.Diagnostics.Append(data.PublicIPIDs.ElementsAs(ctx, &createReq.PublicIPIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating instance", map[string]any{
+		"name":       createReq.Name,
+		"region":     createReq.Region,
+		"project_id": createReq.ProjectID,
+		"image_id":   createReq.ImageID,
+		"cpu":        createReq.CPU,
+		"ram":        createReq.RAM,
+		"disk_size":  createReq.DiskSize,
+		"disk_type":  createReq.DiskType,
+	})
+
+	instance, err := r.client.CreateInstance(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Instance", err)
+		return
+	}
+
+	data.ID = types.Int64Value(instance.ID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+	applyInstance(&data, instance)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err = r.client.WaitForInstanceReady(ctx, instance.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Instance", err)
+		return
+	}
+
+	applyInstance(&data, instance)
+
+	tflog.Debug(ctx, "Created instance", map[string]any{
+		"id":     instance.ID,
+		"name":   instance.Name,
+		"status": instance.Status,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	instanceID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading instance", map[string]any{
+		"id":         instanceID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	instance, err := r.client.GetInstance(ctx, instanceID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Instance not found, removing from state", map[string]any{
+				"id": instanceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Instance", err)
+		return
+	}
+
+	applyInstance(&data, instance)
+	resp.Diagnostics.Append(readTags(ctx, r.client, instance.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read instance", map[string]any{
+		"id":     instanceID,
+		"name":   instance.Name,
+		"status": instance.Status,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InstanceResourceModel
+	var state InstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := state.ID.ValueInt64()
+	plan.ID = state.ID
+
+	// Name, CPU, RAM, tags and a larger disk are applied in place, region and projectId in request body.
+	// Other changes reaching Update are create-only attributes being set after import.
+	if plan.Name.Equal(state.Name) && plan.CPU.Equal(state.CPU) && plan.RAM.Equal(state.RAM) && plan.DiskSize.Equal(state.DiskSize) && plan.TagsAll.Equal(state.TagsAll) {
+		plan.Status = state.Status
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	updateReq := client.UpdateInstanceRequest{
+		Name:     plan.Name.ValueString(),
+		CPU:      plan.CPU.ValueInt64(),
+		RAM:      plan.RAM.ValueInt64(),
+		DiskSize: plan.DiskSize.ValueInt64(),
+		Tags:     tags,
+	}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		updateReq.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updateReq.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Updating instance", map[string]any{
+		"id":         instanceID,
+		"name":       updateReq.Name,
+		"cpu":        updateReq.CPU,
+		"ram":        updateReq.RAM,
+		"disk_size":  updateReq.DiskSize,
+		"region":     updateReq.Region,
+		"project_id": updateReq.ProjectID,
+	})
+
+	if _, err := r.client.UpdateInstance(ctx, instanceID, updateReq); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Instance", err)
+		return
+	}
+
+	instance, err := r.client.WaitForInstanceReady(ctx, instanceID, &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}, updateTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Instance", err)
+		return
+	}
+
+	applyInstance(&plan, instance)
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated instance", map[string]any{
+		"id":     instanceID,
+		"name":   instance.Name,
+		"status": instance.Status,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InstanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	instanceID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting instance", map[string]any{
+		"id":         instanceID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	err := r.client.DeleteInstance(ctx, instanceID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Instance", err)
+		return
+	}
+
+	if err := r.client.WaitForInstanceDeleted(ctx, instanceID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Instance", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted instance", map[string]any{
+		"id": instanceID,
+	})
+}
+
+func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
+
+// applyInstance copies the attributes reported by the API into the model. SSH keys,
+// user data and the attachments requested at creation are not returned by the API
+// and are kept as configured.
+func applyInstance(data *InstanceResourceModel, instance *client.Instance) {
+	data.Name = types.StringValue(instance.Name)
+	data.ImageID = types.Int64Value(instance.ImageID)
+	data.CPU = types.Int64Value(instance.CPU)
+	data.RAM = types.Int64Value(instance.RAM)
+	data.DiskSize = types.Int64Value(instance.DiskSize)
+	data.DiskType = types.StringValue(instance.DiskType)
+	data.Status = types.StringValue(instance.Status)
+}
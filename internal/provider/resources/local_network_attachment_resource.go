@@ -0,0 +1,424 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+	"terraform-provider-prodata/internal/provider/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &LocalNetworkAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &LocalNetworkAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &LocalNetworkAttachmentResource{}
+	_ resource.ResourceWithImportState = &LocalNetworkAttachmentResource{}
+)
+
+type LocalNetworkAttachmentResource struct {
+	client *client.Client
+}
+
+type LocalNetworkAttachmentResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Region         types.String   `tfsdk:"region"`
+	ProjectID      types.Int64    `tfsdk:"project_id"`
+	LocalNetworkID types.Int64    `tfsdk:"local_network_id"`
+	InstanceID     types.Int64    `tfsdk:"instance_id"`
+	PrivateIP      types.String   `tfsdk:"private_ip"`
+	MAC            types.String   `tfsdk:"mac"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewLocalNetworkAttachmentResource() resource.Resource {
+	return &LocalNetworkAttachmentResource{}
+}
+
+func (r *LocalNetworkAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_network_attachment"
+}
+
+func (r *LocalNetworkAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connects a ProData instance to a local network.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the attachment in the form `<local_network_id>/<instance_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"local_network_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the local network. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance to connect. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				MarkdownDescription: "A fixed private IP address for the instance on the network. Must be a host address within the network CIDR other than the gateway. If not specified, an address is assigned automatically. Changing this forces a new resource.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the instance's interface on the network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *LocalNetworkAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan checks a fixed private_ip against the CIDR of the local network, which
+// is only known once the network exists. Create checks it again for networks that
+// did not exist at plan time.
+func (r *LocalNetworkAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan LocalNetworkAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PrivateIP.IsNull() || plan.PrivateIP.IsUnknown() || plan.LocalNetworkID.IsUnknown() {
+		return
+	}
+
+	// Nothing to check when the address is unchanged from state.
+	if !req.State.Raw.IsNull() {
+		var state LocalNetworkAttachmentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.PrivateIP.Equal(plan.PrivateIP) && state.LocalNetworkID.Equal(plan.LocalNetworkID) {
+			return
+		}
+	}
+
+	opts := &client.RequestOpts{}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		opts.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		opts.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	r.checkPrivateIP(ctx, &resp.Diagnostics, plan.LocalNetworkID.ValueInt64(), plan.PrivateIP.ValueString(), opts)
+}
+
+// checkPrivateIP reports a private_ip that cannot be given to a host on the local
+// network.
+func (r *LocalNetworkAttachmentResource) checkPrivateIP(ctx context.Context, diags *diag.Diagnostics, networkID int64, ip string, opts *client.RequestOpts) {
+	network, err := r.client.GetLocalNetwork(ctx, networkID, opts)
+	if err != nil {
+		diagutil.AddAPIError(diags, "Unable to Read Local Network", err)
+		return
+	}
+
+	if err := validatePrivateIP(ip, network.CIDR, network.Gateway); err != nil {
+		diags.AddAttributeError(path.Root("private_ip"), "Invalid Private IP",
+			fmt.Sprintf("The private_ip cannot be used on local network %d (%s): %s.", network.ID, network.CIDR, err))
+	}
+}
+
+func (r *LocalNetworkAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LocalNetworkAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	networkID := data.LocalNetworkID.ValueInt64()
+	attachReq := client.AttachLocalNetworkRequest{
+		Region:     region,
+		ProjectID:  projectID,
+		InstanceID: data.InstanceID.ValueInt64(),
+	}
+	if !data.PrivateIP.IsNull() && !data.PrivateIP.IsUnknown() {
+		attachReq.IP = data.PrivateIP.ValueString()
+
+		// The network is usually created in the same apply, in which case the
+		// address could not be checked at plan time.
+		r.checkPrivateIP(ctx, &resp.Diagnostics, networkID, attachReq.IP, &client.RequestOpts{Region: region, ProjectID: projectID})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Attaching local network", map[string]any{
+		"local_network_id": networkID,
+		"instance_id":      attachReq.InstanceID,
+		"private_ip":       attachReq.IP,
+		"region":           region,
+		"project_id":       projectID,
+	})
+
+	if err := r.client.AttachLocalNetwork(ctx, networkID, attachReq); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Attach Local Network", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", networkID, attachReq.InstanceID))
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+	data.PrivateIP = types.StringValue(attachReq.IP)
+	data.MAC = types.StringValue("")
+
+	// Save the attachment before waiting so that a failed wait taints the resource.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nic, err := r.client.WaitForLocalNetworkAttachment(ctx, networkID, attachReq.InstanceID, true,
+		&client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Attach Local Network", err)
+		return
+	}
+
+	data.PrivateIP = types.StringValue(nic.IP)
+	data.MAC = types.StringValue(nic.MAC)
+
+	tflog.Debug(ctx, "Attached local network", map[string]any{
+		"local_network_id": networkID,
+		"instance_id":      attachReq.InstanceID,
+		"private_ip":       nic.IP,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LocalNetworkAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LocalNetworkAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	networkID := data.LocalNetworkID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading local network attachment", map[string]any{
+		"local_network_id": networkID,
+		"instance_id":      instanceID,
+		"region":           opts.Region,
+		"project_id":       opts.ProjectID,
+	})
+
+	instance, err := r.client.GetInstance(ctx, instanceID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Instance not found, removing local network attachment from state", map[string]any{
+				"instance_id": instanceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Local Network Attachment", err)
+		return
+	}
+
+	nic := instance.NetworkInterface(networkID)
+	if nic == nil {
+		tflog.Warn(ctx, "Instance is no longer attached to the local network, removing from state", map[string]any{
+			"local_network_id": networkID,
+			"instance_id":      instanceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", networkID, instanceID))
+	data.PrivateIP = types.StringValue(nic.IP)
+	data.MAC = types.StringValue(nic.MAC)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LocalNetworkAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LocalNetworkAttachmentResourceModel
+
+	// All attributes force replacement; only the timeouts can change in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LocalNetworkAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LocalNetworkAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	networkID := data.LocalNetworkID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Detaching local network", map[string]any{
+		"local_network_id": networkID,
+		"instance_id":      instanceID,
+		"region":           opts.Region,
+		"project_id":       opts.ProjectID,
+	})
+
+	err := r.client.DetachLocalNetwork(ctx, networkID, client.DetachLocalNetworkRequest{
+		Region:     opts.Region,
+		ProjectID:  opts.ProjectID,
+		InstanceID: instanceID,
+	})
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Detach Local Network", err)
+		return
+	}
+
+	_, err = r.client.WaitForLocalNetworkAttachment(ctx, networkID, instanceID, false, opts, deleteTimeout)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Detach Local Network", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Detached local network", map[string]any{
+		"local_network_id": networkID,
+		"instance_id":      instanceID,
+	})
+}
+
+// ImportState accepts `<local_network_id>/<instance_id>` or `<region>/<project_id>/<local_network_id>/<instance_id>`.
+func (r *LocalNetworkAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsed, ok := importPairResource(ctx, r.client, req, resp, "local_network_id", "instance_id")
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d/%d", parsed.First, parsed.Second))...)
+}
+
+// validatePrivateIP checks that ip is a usable host address in cidr other than the gateway.
+func validatePrivateIP(ip, cidr, gateway string) error {
+	if err := validators.HostAddressError(ip, cidr); err != nil {
+		return err
+	}
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		if gw, err := netip.ParseAddr(gateway); err == nil && gw == addr {
+			return fmt.Errorf("%s is the gateway address", addr)
+		}
+	}
+	return nil
+}
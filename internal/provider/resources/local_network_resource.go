@@ -5,19 +5,33 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+	"terraform-provider-prodata/internal/provider/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &LocalNetworkResource{}
-	_ resource.ResourceWithConfigure = &LocalNetworkResource{}
+	_ resource.Resource                     = &LocalNetworkResource{}
+	_ resource.ResourceWithConfigure        = &LocalNetworkResource{}
+	_ resource.ResourceWithModifyPlan       = &LocalNetworkResource{}
+	_ resource.ResourceWithConfigValidators = &LocalNetworkResource{}
+	_ resource.ResourceWithImportState      = &LocalNetworkResource{}
+)
+
+// Prefix lengths accepted for a local network's CIDR block.
+const (
+	localNetworkMinPrefix = 16
+	localNetworkMaxPrefix = 29
 )
 
 type LocalNetworkResource struct {
@@ -25,12 +39,16 @@ type LocalNetworkResource struct {
 }
 
 type LocalNetworkResourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	Region    types.String `tfsdk:"region"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
-	Name      types.String `tfsdk:"name"`
-	CIDR      types.String `tfsdk:"cidr"`
-	Gateway   types.String `tfsdk:"gateway"`
+	ID        types.Int64    `tfsdk:"id"`
+	Region    types.String   `tfsdk:"region"`
+	ProjectID types.Int64    `tfsdk:"project_id"`
+	Name      types.String   `tfsdk:"name"`
+	CIDR      types.String   `tfsdk:"cidr"`
+	Gateway   types.String   `tfsdk:"gateway"`
+	Linked    types.Bool     `tfsdk:"linked"`
+	Tags      types.Map      `tfsdk:"tags"`
+	TagsAll   types.Map      `tfsdk:"tags_all"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewLocalNetworkResource() resource.Resource {
@@ -42,6 +60,8 @@ func (r *LocalNetworkResource) Metadata(ctx context.Context, req resource.Metada
 }
 
 func (r *LocalNetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("local network")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a ProData local network.",
 
@@ -72,15 +92,18 @@ func (r *LocalNetworkResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the local network. This is the only attribute that can be updated in-place.",
+				MarkdownDescription: "The name of the local network. This and `tags` are the only attributes that can be updated in-place.",
 				Required:            true,
 			},
 			"cidr": schema.StringAttribute{
-				MarkdownDescription: "The CIDR block for the local network (e.g., 10.0.0.0/24).",
+				MarkdownDescription: "The CIDR block for the local network (e.g., 10.0.0.0/24). Must be a private IPv4 range between /16 and /29.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					validators.PrivateIPv4CIDR(localNetworkMinPrefix, localNetworkMaxPrefix),
+				},
 			},
 			"gateway": schema.StringAttribute{
 				MarkdownDescription: "The gateway IP address for the local network (e.g., 10.0.0.1).",
@@ -89,10 +112,30 @@ func (r *LocalNetworkResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"linked": schema.BoolAttribute{
+				MarkdownDescription: "Whether any instance is connected to the local network. Use `prodata_local_network_attachment` to connect one.",
+				Computed:            true,
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+func (r *LocalNetworkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.GatewayInCIDR(path.Root("cidr"), path.Root("gateway")),
+	}
+}
+
 func (r *LocalNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -110,6 +153,11 @@ func (r *LocalNetworkResource) Configure(ctx context.Context, req resource.Confi
 	r.client = c
 }
 
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *LocalNetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
 func (r *LocalNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data LocalNetworkResourceModel
 
@@ -118,6 +166,18 @@ func (r *LocalNetworkResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use provider defaults if not specified in resource
 	region := data.Region.ValueString()
 	if region == "" {
@@ -134,6 +194,7 @@ func (r *LocalNetworkResource) Create(ctx context.Context, req resource.CreateRe
 		Name:      data.Name.ValueString(),
 		CIDR:      data.CIDR.ValueString(),
 		Gateway:   data.Gateway.ValueString(),
+		Tags:      tags,
 	}
 
 	tflog.Debug(ctx, "Creating local network", map[string]any{
@@ -146,7 +207,7 @@ func (r *LocalNetworkResource) Create(ctx context.Context, req resource.CreateRe
 
 	network, err := r.client.CreateLocalNetwork(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Create Local Network", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Local Network", err)
 		return
 	}
 
@@ -156,6 +217,25 @@ func (r *LocalNetworkResource) Create(ctx context.Context, req resource.CreateRe
 	data.Name = types.StringValue(network.Name)
 	data.CIDR = types.StringValue(network.CIDR)
 	data.Gateway = types.StringValue(network.Gateway)
+	data.Linked = types.BoolValue(network.Linked)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.WaitForLocalNetworkReady(ctx, network.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Local Network", err)
+		return
+	}
+
+	data.Name = types.StringValue(network.Name)
+	data.CIDR = types.StringValue(network.CIDR)
+	data.Gateway = types.StringValue(network.Gateway)
+	data.Linked = types.BoolValue(network.Linked)
 
 	tflog.Debug(ctx, "Created local network", map[string]any{
 		"id":   network.ID,
@@ -192,13 +272,22 @@ func (r *LocalNetworkResource) Read(ctx context.Context, req resource.ReadReques
 
 	network, err := r.client.GetLocalNetwork(ctx, networkID, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Local Network", err.Error())
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Local network not found, removing from state", map[string]any{
+				"id": networkID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Local Network", err)
 		return
 	}
 
 	data.Name = types.StringValue(network.Name)
 	data.CIDR = types.StringValue(network.CIDR)
 	data.Gateway = types.StringValue(network.Gateway)
+	data.Linked = types.BoolValue(network.Linked)
+	resp.Diagnostics.Append(readTags(ctx, r.client, network.Tags, &data.Tags, &data.TagsAll)...)
 
 	tflog.Debug(ctx, "Read local network", map[string]any{
 		"id":   networkID,
@@ -218,11 +307,24 @@ func (r *LocalNetworkResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	networkID := state.ID.ValueInt64()
 
-	// Only name can be updated via API, region and projectId in request body
+	// Only name and tags can be updated via API, region and projectId in request body
 	updateReq := client.UpdateLocalNetworkRequest{
 		Name: plan.Name.ValueString(),
+		Tags: tags,
 	}
 	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
 		updateReq.Region = plan.Region.ValueString()
@@ -240,7 +342,13 @@ func (r *LocalNetworkResource) Update(ctx context.Context, req resource.UpdateRe
 
 	network, err := r.client.UpdateLocalNetwork(ctx, networkID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Update Local Network", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Local Network", err)
+		return
+	}
+
+	network, err = r.client.WaitForLocalNetworkReady(ctx, networkID, &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}, updateTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Local Network", err)
 		return
 	}
 
@@ -248,6 +356,8 @@ func (r *LocalNetworkResource) Update(ctx context.Context, req resource.UpdateRe
 	plan.Name = types.StringValue(network.Name)
 	plan.CIDR = types.StringValue(network.CIDR)
 	plan.Gateway = types.StringValue(network.Gateway)
+	plan.Linked = types.BoolValue(network.Linked)
+	plan.TagsAll = tagsAll
 
 	tflog.Debug(ctx, "Updated local network", map[string]any{
 		"id":   networkID,
@@ -265,6 +375,12 @@ func (r *LocalNetworkResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Only set opts if explicitly provided in resource (overrides provider defaults)
 	opts := &client.RequestOpts{}
 	if !data.Region.IsNull() && !data.Region.IsUnknown() {
@@ -283,8 +399,13 @@ func (r *LocalNetworkResource) Delete(ctx context.Context, req resource.DeleteRe
 	})
 
 	err := r.client.DeleteLocalNetwork(ctx, networkID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Delete Local Network", err.Error())
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Local Network", err)
+		return
+	}
+
+	if err := r.client.WaitForLocalNetworkDeleted(ctx, networkID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Local Network", err)
 		return
 	}
 
@@ -292,3 +413,7 @@ func (r *LocalNetworkResource) Delete(ctx context.Context, req resource.DeleteRe
 		"id": networkID,
 	})
 }
+
+func (r *LocalNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
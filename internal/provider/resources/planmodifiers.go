@@ -0,0 +1,135 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	decreasedDescription  = "Changing this to a smaller value forces a new resource; growing it is done in place."
+	createOnlyDescription = "Changing this forces a new resource, except when the value was not known after import."
+
+	int64CreateOnlyDescription = "Changing this forces a new resource. Removing it from the configuration keeps the current value."
+)
+
+// int64RequiresReplaceIfDecreased forces replacement only when the planned value is
+// lower than the current one, for sizes that the API can grow but not shrink.
+func int64RequiresReplaceIfDecreased() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+				return
+			}
+			resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+		},
+		decreasedDescription,
+		decreasedDescription,
+	)
+}
+
+// The createOnly modifiers force replacement when an attribute that the API only
+// accepts at creation changes. Imported resources start with a null value because
+// the API does not return it, so setting it for the first time is not a change.
+
+func stringCreateOnly() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		createOnlyDescription,
+		createOnlyDescription,
+	)
+}
+
+func listCreateOnly() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		createOnlyDescription,
+		createOnlyDescription,
+	)
+}
+
+func setCreateOnly() planmodifier.Set {
+	return setplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		createOnlyDescription,
+		createOnlyDescription,
+	)
+}
+
+// int64CreateOnly is used with Computed attributes that also carry
+// UseStateForUnknown, so removing the value from the configuration keeps the one
+// in state. It only forces replacement when a value in state is changed to another
+// value. Setting a value that state does not hold, such as after import, cannot be
+// applied to the existing object and is only recorded with a warning.
+func int64CreateOnly() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			if req.PlanValue.IsNull() {
+				return
+			}
+			if req.StateValue.IsNull() {
+				addCreateOnlyWarning(&resp.Diagnostics, req.Path)
+				return
+			}
+			resp.RequiresReplace = true
+		},
+		int64CreateOnlyDescription,
+		int64CreateOnlyDescription,
+	)
+}
+
+// int64CreateOnlySource is int64CreateOnly for one of two mutually exclusive
+// attributes that a resource is created from. Setting it while the other one is
+// held in state switches sources, which forces replacement instead of warning.
+func int64CreateOnlySource(other path.Path) planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			if req.PlanValue.IsNull() {
+				return
+			}
+			if req.StateValue.IsNull() {
+				var otherState types.Int64
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, other, &otherState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if otherState.IsNull() {
+					addCreateOnlyWarning(&resp.Diagnostics, req.Path)
+					return
+				}
+			}
+			resp.RequiresReplace = true
+		},
+		int64CreateOnlyDescription,
+		int64CreateOnlyDescription,
+	)
+}
+
+func addCreateOnlyWarning(diags *diag.Diagnostics, p path.Path) {
+	diags.AddAttributeWarning(p, "Value Only Used at Creation",
+		fmt.Sprintf("%s is only used when the resource is created. The new value is recorded in state but does not change "+
+			"the existing resource. Use `terraform apply -replace` to recreate the resource from it.", p))
+}
+
+// nullIfUnknown returns null for a Computed create-only attribute that is still
+// unknown after planning, i.e. that was neither configured nor held in state.
+func nullIfUnknown(v types.Int64) types.Int64 {
+	if v.IsUnknown() {
+		return types.Int64Null()
+	}
+	return v
+}
@@ -0,0 +1,302 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ProjectResource{}
+	_ resource.ResourceWithConfigure   = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
+	_ resource.ResourceWithImportState = &ProjectResource{}
+)
+
+type ProjectResource struct {
+	client *client.Client
+}
+
+type ProjectResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Tags      types.Map    `tfsdk:"tags"`
+	TagsAll   types.Map    `tfsdk:"tags_all"`
+}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("project")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ProData project. A project can only be deleted once it holds no resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the project. Use it as `project_id` of other resources.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project. This and `tags` are the only attributes that can be updated in-place.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the project was created (RFC 3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateProjectRequest{
+		Name: data.Name.ValueString(),
+		Tags: tags,
+	}
+
+	tflog.Debug(ctx, "Creating project", map[string]any{
+		"name": createReq.Name,
+	})
+
+	project, err := r.client.CreateProject(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Project", err)
+		return
+	}
+
+	data.ID = types.Int64Value(project.ID)
+	data.Name = types.StringValue(project.Name)
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	data.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Created project", map[string]any{
+		"id": project.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading project", map[string]any{
+		"id": projectID,
+	})
+
+	project, err := r.client.GetProject(ctx, projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Project not found, removing from state", map[string]any{
+				"id": projectID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Project", err)
+		return
+	}
+
+	data.Name = types.StringValue(project.Name)
+	data.CreatedAt = types.StringValue(project.CreatedAt)
+	resp.Diagnostics.Append(readTags(ctx, r.client, project.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read project", map[string]any{
+		"id":   projectID,
+		"name": project.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectResourceModel
+	var state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ID.ValueInt64()
+
+	updateReq := client.UpdateProjectRequest{
+		Name: plan.Name.ValueString(),
+		Tags: tags,
+	}
+
+	tflog.Debug(ctx, "Updating project", map[string]any{
+		"id":   projectID,
+		"name": updateReq.Name,
+	})
+
+	project, err := r.client.UpdateProject(ctx, projectID, updateReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Project", err)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(project.Name)
+	plan.CreatedAt = state.CreatedAt
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated project", map[string]any{
+		"id":   projectID,
+		"name": project.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting project", map[string]any{
+		"id": projectID,
+	})
+
+	err := r.client.DeleteProject(ctx, projectID)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Project", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted project", map[string]any{
+		"id": projectID,
+	})
+}
+
+// ImportState accepts either the numeric ID of the project or its name. A name
+// shared by several projects is rejected.
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Looking up project by name", map[string]any{
+		"name": req.ID,
+	})
+
+	projects, err := r.client.GetProjects(ctx, &client.ListFilter{Name: req.ID})
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Import Project", err)
+		return
+	}
+
+	var matches []client.Project
+	for _, project := range projects {
+		if project.Name == req.ID {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0].ID)...)
+	case 0:
+		resp.Diagnostics.AddError(
+			"Project Not Found",
+			fmt.Sprintf("No project with ID or name %q exists.", req.ID),
+		)
+	default:
+		ids := make([]string, len(matches))
+		for i, project := range matches {
+			ids[i] = strconv.FormatInt(project.ID, 10)
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Projects Found",
+			fmt.Sprintf("%d projects are named %q (IDs %s). Import the project by ID instead.", len(ids), req.ID, strings.Join(ids, ", ")),
+		)
+	}
+}
@@ -0,0 +1,381 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &PublicIPAssociationResource{}
+	_ resource.ResourceWithConfigure   = &PublicIPAssociationResource{}
+	_ resource.ResourceWithImportState = &PublicIPAssociationResource{}
+)
+
+type PublicIPAssociationResource struct {
+	client *client.Client
+}
+
+type PublicIPAssociationResourceModel struct {
+	ID         types.Int64    `tfsdk:"id"`
+	Region     types.String   `tfsdk:"region"`
+	ProjectID  types.Int64    `tfsdk:"project_id"`
+	PublicIPID types.Int64    `tfsdk:"public_ip_id"`
+	InstanceID types.Int64    `tfsdk:"instance_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewPublicIPAssociationResource() resource.Resource {
+	return &PublicIPAssociationResource{}
+}
+
+func (r *PublicIPAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_ip_association"
+}
+
+func (r *PublicIPAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Binds a ProData public IP to an instance. Changing `instance_id` moves the address in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The identifier of the association, equal to `public_ip_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_ip_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the public IP. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance to bind the address to. Changing this moves the address to the new instance.",
+				Required:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *PublicIPAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PublicIPAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PublicIPAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+	opts := &client.RequestOpts{Region: region, ProjectID: projectID}
+
+	ipID := data.PublicIPID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Associating public IP", map[string]any{
+		"public_ip_id": ipID,
+		"instance_id":  instanceID,
+		"region":       region,
+		"project_id":   projectID,
+	})
+
+	ip, err := r.client.GetPublicIP(ctx, ipID, opts)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Associate Public IP", err)
+		return
+	}
+
+	switch {
+	case ip.AttachedInstanceID == nil:
+		r.attach(ctx, ipID, instanceID, opts, createTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	case *ip.AttachedInstanceID == instanceID:
+		tflog.Debug(ctx, "Public IP is already associated with the instance", map[string]any{
+			"public_ip_id": ipID,
+			"instance_id":  instanceID,
+		})
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("public_ip_id"), "Public IP Already In Use",
+			fmt.Sprintf("Public IP %d (%s) is bound to instance %d and cannot be associated with instance %d. "+
+				"Change instance_id on the prodata_public_ip_association that manages it to move the address.",
+				ipID, ip.IP, *ip.AttachedInstanceID, instanceID))
+		return
+	}
+
+	data.ID = types.Int64Value(ipID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+
+	tflog.Debug(ctx, "Associated public IP", map[string]any{
+		"public_ip_id": ipID,
+		"instance_id":  instanceID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicIPAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PublicIPAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	// After import only the ID is known.
+	ipID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading public IP association", map[string]any{
+		"public_ip_id": ipID,
+		"region":       opts.Region,
+		"project_id":   opts.ProjectID,
+	})
+
+	ip, err := r.client.GetPublicIP(ctx, ipID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Public IP not found, removing association from state", map[string]any{
+				"public_ip_id": ipID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Public IP Association", err)
+		return
+	}
+
+	if ip.AttachedInstanceID == nil {
+		tflog.Warn(ctx, "Public IP is no longer associated with an instance, removing from state", map[string]any{
+			"public_ip_id": ipID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// An address moved outside Terraform shows up as a change of instance_id.
+	data.PublicIPID = types.Int64Value(ip.ID)
+	data.InstanceID = types.Int64Value(*ip.AttachedInstanceID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicIPAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PublicIPAssociationResourceModel
+	var state PublicIPAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.RequestOpts{}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		opts.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		opts.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	ipID := state.ID.ValueInt64()
+	oldInstanceID := state.InstanceID.ValueInt64()
+	newInstanceID := plan.InstanceID.ValueInt64()
+
+	if oldInstanceID != newInstanceID {
+		tflog.Debug(ctx, "Moving public IP", map[string]any{
+			"public_ip_id":     ipID,
+			"from_instance_id": oldInstanceID,
+			"to_instance_id":   newInstanceID,
+		})
+
+		r.detach(ctx, ipID, opts, updateTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.attach(ctx, ipID, newInstanceID, opts, updateTimeout, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Moved public IP", map[string]any{
+			"public_ip_id": ipID,
+			"instance_id":  newInstanceID,
+		})
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PublicIPAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PublicIPAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	ipID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Removing public IP association", map[string]any{
+		"public_ip_id": ipID,
+		"instance_id":  data.InstanceID.ValueInt64(),
+		"region":       opts.Region,
+		"project_id":   opts.ProjectID,
+	})
+
+	r.detach(ctx, ipID, opts, deleteTimeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removed public IP association", map[string]any{
+		"public_ip_id": ipID,
+	})
+}
+
+// ImportState accepts the public IP ID, either bare or as `<region>/<project_id>/<public_ip_id>`.
+func (r *PublicIPAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
+
+// attach binds the address to instanceID and waits until the API reports it.
+func (r *PublicIPAssociationResource) attach(ctx context.Context, ipID, instanceID int64, opts *client.RequestOpts, timeout time.Duration, diags *diag.Diagnostics) {
+	_, err := r.client.AttachPublicIP(ctx, ipID, client.AttachPublicIPRequest{
+		Region:     opts.Region,
+		ProjectID:  opts.ProjectID,
+		InstanceID: instanceID,
+	})
+	if err != nil {
+		diagutil.AddAPIError(diags, "Unable to Associate Public IP", err)
+		return
+	}
+
+	if _, err := r.client.WaitForPublicIPAttachment(ctx, ipID, instanceID, opts, timeout); err != nil {
+		diagutil.AddAPIError(diags, "Unable to Associate Public IP", err)
+	}
+}
+
+// detach unbinds the address and waits until the API reports it free. A missing
+// address counts as detached.
+func (r *PublicIPAssociationResource) detach(ctx context.Context, ipID int64, opts *client.RequestOpts, timeout time.Duration, diags *diag.Diagnostics) {
+	err := r.client.DetachPublicIP(ctx, ipID, client.DetachPublicIPRequest{
+		Region:    opts.Region,
+		ProjectID: opts.ProjectID,
+	})
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(diags, "Unable to Remove Public IP Association", err)
+		return
+	}
+
+	_, err = r.client.WaitForPublicIPAttachment(ctx, ipID, 0, opts, timeout)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(diags, "Unable to Remove Public IP Association", err)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -16,8 +18,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &PublicIPResource{}
-	_ resource.ResourceWithConfigure = &PublicIPResource{}
+	_ resource.Resource                = &PublicIPResource{}
+	_ resource.ResourceWithConfigure   = &PublicIPResource{}
+	_ resource.ResourceWithModifyPlan  = &PublicIPResource{}
+	_ resource.ResourceWithImportState = &PublicIPResource{}
 )
 
 type PublicIPResource struct {
@@ -25,13 +29,17 @@ type PublicIPResource struct {
 }
 
 type PublicIPResourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	Region    types.String `tfsdk:"region"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
-	Name      types.String `tfsdk:"name"`
-	IP        types.String `tfsdk:"ip"`
-	Mask      types.String `tfsdk:"mask"`
-	Gateway   types.String `tfsdk:"gateway"`
+	ID                 types.Int64    `tfsdk:"id"`
+	Region             types.String   `tfsdk:"region"`
+	ProjectID          types.Int64    `tfsdk:"project_id"`
+	Name               types.String   `tfsdk:"name"`
+	IP                 types.String   `tfsdk:"ip"`
+	Mask               types.String   `tfsdk:"mask"`
+	Gateway            types.String   `tfsdk:"gateway"`
+	AttachedInstanceID types.Int64    `tfsdk:"attached_instance_id"`
+	Tags               types.Map      `tfsdk:"tags"`
+	TagsAll            types.Map      `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewPublicIPResource() resource.Resource {
@@ -43,6 +51,8 @@ func (r *PublicIPResource) Metadata(ctx context.Context, req resource.MetadataRe
 }
 
 func (r *PublicIPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("public IP")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a ProData public IP address.",
 
@@ -73,7 +83,7 @@ func (r *PublicIPResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the public IP. This is the only attribute that can be updated in-place.",
+				MarkdownDescription: "The name of the public IP. This and `tags` are the only attributes that can be updated in-place.",
 				Required:            true,
 			},
 			"ip": schema.StringAttribute{
@@ -97,6 +107,20 @@ func (r *PublicIPResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"attached_instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance the address is bound to, or `null` if it is free. Use `prodata_public_ip_association` to bind it.",
+				Computed:            true,
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -118,6 +142,11 @@ func (r *PublicIPResource) Configure(ctx context.Context, req resource.Configure
 	r.client = c
 }
 
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *PublicIPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
 func (r *PublicIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data PublicIPResourceModel
 
@@ -126,6 +155,18 @@ func (r *PublicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use provider defaults if not specified in resource
 	region := data.Region.ValueString()
 	if region == "" {
@@ -140,6 +181,7 @@ func (r *PublicIPResource) Create(ctx context.Context, req resource.CreateReques
 		Region:    region,
 		ProjectID: projectID,
 		Name:      data.Name.ValueString(),
+		Tags:      tags,
 	}
 
 	tflog.Debug(ctx, "Creating public IP", map[string]any{
@@ -150,7 +192,7 @@ func (r *PublicIPResource) Create(ctx context.Context, req resource.CreateReques
 
 	ip, err := r.client.CreatePublicIP(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Create Public IP", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Public IP", err)
 		return
 	}
 
@@ -161,6 +203,26 @@ func (r *PublicIPResource) Create(ctx context.Context, req resource.CreateReques
 	data.IP = types.StringValue(ip.IP)
 	data.Mask = types.StringValue(ip.Mask)
 	data.Gateway = types.StringValue(ip.Gateway)
+	data.AttachedInstanceID = types.Int64PointerValue(ip.AttachedInstanceID)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err = r.client.WaitForPublicIPReady(ctx, ip.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Public IP", err)
+		return
+	}
+
+	data.Name = types.StringValue(ip.Name)
+	data.IP = types.StringValue(ip.IP)
+	data.Mask = types.StringValue(ip.Mask)
+	data.Gateway = types.StringValue(ip.Gateway)
+	data.AttachedInstanceID = types.Int64PointerValue(ip.AttachedInstanceID)
 
 	tflog.Debug(ctx, "Created public IP", map[string]any{
 		"id":   ip.ID,
@@ -198,7 +260,14 @@ func (r *PublicIPResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	ip, err := r.client.GetPublicIP(ctx, ipID, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Public IP", err.Error())
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Public IP not found, removing from state", map[string]any{
+				"id": ipID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Public IP", err)
 		return
 	}
 
@@ -206,6 +275,8 @@ func (r *PublicIPResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.IP = types.StringValue(ip.IP)
 	data.Mask = types.StringValue(ip.Mask)
 	data.Gateway = types.StringValue(ip.Gateway)
+	data.AttachedInstanceID = types.Int64PointerValue(ip.AttachedInstanceID)
+	resp.Diagnostics.Append(readTags(ctx, r.client, ip.Tags, &data.Tags, &data.TagsAll)...)
 
 	tflog.Debug(ctx, "Read public IP", map[string]any{
 		"id":   ipID,
@@ -226,11 +297,24 @@ func (r *PublicIPResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ipID := state.ID.ValueInt64()
 
-	// Only name can be updated via API, region and projectId in request body
+	// Only name and tags can be updated via API, region and projectId in request body
 	updateReq := client.UpdatePublicIPRequest{
 		Name: plan.Name.ValueString(),
+		Tags: tags,
 	}
 	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
 		updateReq.Region = plan.Region.ValueString()
@@ -248,7 +332,13 @@ func (r *PublicIPResource) Update(ctx context.Context, req resource.UpdateReques
 
 	ip, err := r.client.UpdatePublicIP(ctx, ipID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Update Public IP", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Public IP", err)
+		return
+	}
+
+	ip, err = r.client.WaitForPublicIPReady(ctx, ipID, &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}, updateTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Public IP", err)
 		return
 	}
 
@@ -257,6 +347,8 @@ func (r *PublicIPResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.IP = types.StringValue(ip.IP)
 	plan.Mask = types.StringValue(ip.Mask)
 	plan.Gateway = types.StringValue(ip.Gateway)
+	plan.AttachedInstanceID = types.Int64PointerValue(ip.AttachedInstanceID)
+	plan.TagsAll = tagsAll
 
 	tflog.Debug(ctx, "Updated public IP", map[string]any{
 		"id":   ipID,
@@ -274,6 +366,12 @@ func (r *PublicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Only set opts if explicitly provided in resource (overrides provider defaults)
 	opts := &client.RequestOpts{}
 	if !data.Region.IsNull() && !data.Region.IsUnknown() {
@@ -292,8 +390,13 @@ func (r *PublicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 	})
 
 	err := r.client.DeletePublicIP(ctx, ipID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Delete Public IP", err.Error())
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Public IP", err)
+		return
+	}
+
+	if err := r.client.WaitForPublicIPDeleted(ctx, ipID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Public IP", err)
 		return
 	}
 
@@ -301,3 +404,7 @@ func (r *PublicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 		"id": ipID,
 	})
 }
+
+func (r *PublicIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
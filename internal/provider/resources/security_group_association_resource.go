@@ -0,0 +1,274 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SecurityGroupAssociationResource{}
+	_ resource.ResourceWithConfigure   = &SecurityGroupAssociationResource{}
+	_ resource.ResourceWithImportState = &SecurityGroupAssociationResource{}
+)
+
+type SecurityGroupAssociationResource struct {
+	client *client.Client
+}
+
+type SecurityGroupAssociationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Region          types.String `tfsdk:"region"`
+	ProjectID       types.Int64  `tfsdk:"project_id"`
+	SecurityGroupID types.Int64  `tfsdk:"security_group_id"`
+	InstanceID      types.Int64  `tfsdk:"instance_id"`
+}
+
+func NewSecurityGroupAssociationResource() resource.Resource {
+	return &SecurityGroupAssociationResource{}
+}
+
+func (r *SecurityGroupAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_association"
+}
+
+func (r *SecurityGroupAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Applies a ProData security group to an instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the association in the form `<security_group_id>/<instance_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the security group. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance to apply the security group to. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityGroupAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SecurityGroupAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecurityGroupAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Associating security group", map[string]any{
+		"security_group_id": groupID,
+		"instance_id":       instanceID,
+		"region":            region,
+		"project_id":        projectID,
+	})
+
+	err := r.client.AttachSecurityGroup(ctx, groupID, client.AttachSecurityGroupRequest{
+		Region:     region,
+		ProjectID:  projectID,
+		InstanceID: instanceID,
+	})
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Associate Security Group", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", groupID, instanceID))
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+
+	tflog.Debug(ctx, "Associated security group", map[string]any{
+		"security_group_id": groupID,
+		"instance_id":       instanceID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityGroupAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading security group association", map[string]any{
+		"security_group_id": groupID,
+		"instance_id":       instanceID,
+		"region":            opts.Region,
+		"project_id":        opts.ProjectID,
+	})
+
+	group, err := r.client.GetSecurityGroup(ctx, groupID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Security group not found, removing association from state", map[string]any{
+				"security_group_id": groupID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Security Group Association", err)
+		return
+	}
+
+	if !slices.Contains(group.InstanceIDs, instanceID) {
+		tflog.Warn(ctx, "Security group is no longer associated with the instance, removing from state", map[string]any{
+			"security_group_id": groupID,
+			"instance_id":       instanceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", groupID, instanceID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SecurityGroupAssociationResourceModel
+
+	// Every attribute forces replacement, so there is nothing to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityGroupAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecurityGroupAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Removing security group association", map[string]any{
+		"security_group_id": groupID,
+		"instance_id":       instanceID,
+		"region":            opts.Region,
+		"project_id":        opts.ProjectID,
+	})
+
+	err := r.client.DetachSecurityGroup(ctx, groupID, instanceID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Remove Security Group Association", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Removed security group association", map[string]any{
+		"security_group_id": groupID,
+		"instance_id":       instanceID,
+	})
+}
+
+// ImportState accepts `<security_group_id>/<instance_id>` or `<region>/<project_id>/<security_group_id>/<instance_id>`.
+func (r *SecurityGroupAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsed, ok := importPairResource(ctx, r.client, req, resp, "security_group_id", "instance_id")
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d/%d", parsed.First, parsed.Second))...)
+}
@@ -0,0 +1,324 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SecurityGroupResource{}
+	_ resource.ResourceWithConfigure   = &SecurityGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &SecurityGroupResource{}
+	_ resource.ResourceWithImportState = &SecurityGroupResource{}
+)
+
+type SecurityGroupResource struct {
+	client *client.Client
+}
+
+type SecurityGroupResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Region      types.String `tfsdk:"region"`
+	ProjectID   types.Int64  `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+}
+
+func NewSecurityGroupResource() resource.Resource {
+	return &SecurityGroupResource{}
+}
+
+func (r *SecurityGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group"
+}
+
+func (r *SecurityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("security group")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ProData security group. Rules are managed with `prodata_security_group_rule` " +
+			"and instances are added with `prodata_security_group_association`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the security group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID where the security group will be created. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the security group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the security group.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+	}
+}
+
+func (r *SecurityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *SecurityGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
+func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	createReq := client.CreateSecurityGroupRequest{
+		Region:      region,
+		ProjectID:   projectID,
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        tags,
+	}
+
+	tflog.Debug(ctx, "Creating security group", map[string]any{
+		"name":       createReq.Name,
+		"region":     createReq.Region,
+		"project_id": createReq.ProjectID,
+	})
+
+	group, err := r.client.CreateSecurityGroup(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Security Group", err)
+		return
+	}
+
+	data.ID = types.Int64Value(group.ID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+	data.Name = types.StringValue(group.Name)
+	data.Description = types.StringValue(group.Description)
+	data.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Created security group", map[string]any{
+		"id":   group.ID,
+		"name": group.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading security group", map[string]any{
+		"id":         groupID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	group, err := r.client.GetSecurityGroup(ctx, groupID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Security group not found, removing from state", map[string]any{
+				"id": groupID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Security Group", err)
+		return
+	}
+
+	data.Name = types.StringValue(group.Name)
+	data.Description = types.StringValue(group.Description)
+	resp.Diagnostics.Append(readTags(ctx, r.client, group.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read security group", map[string]any{
+		"id":   groupID,
+		"name": group.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SecurityGroupResourceModel
+	var state SecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.ID.ValueInt64()
+
+	// Name, description and tags can be updated via API, region and projectId in request body
+	updateReq := client.UpdateSecurityGroupRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Tags:        tags,
+	}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		updateReq.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updateReq.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Updating security group", map[string]any{
+		"id":         groupID,
+		"name":       updateReq.Name,
+		"region":     updateReq.Region,
+		"project_id": updateReq.ProjectID,
+	})
+
+	group, err := r.client.UpdateSecurityGroup(ctx, groupID, updateReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Security Group", err)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(group.Name)
+	plan.Description = types.StringValue(group.Description)
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated security group", map[string]any{
+		"id":   groupID,
+		"name": group.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting security group", map[string]any{
+		"id":         groupID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	err := r.client.DeleteSecurityGroup(ctx, groupID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Security Group", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted security group", map[string]any{
+		"id": groupID,
+	})
+}
+
+func (r *SecurityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
@@ -0,0 +1,415 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithConfigure        = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithConfigValidators = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithValidateConfig   = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithImportState      = &SecurityGroupRuleResource{}
+)
+
+type SecurityGroupRuleResource struct {
+	client *client.Client
+}
+
+type SecurityGroupRuleResourceModel struct {
+	ID                    types.Int64  `tfsdk:"id"`
+	Region                types.String `tfsdk:"region"`
+	ProjectID             types.Int64  `tfsdk:"project_id"`
+	SecurityGroupID       types.Int64  `tfsdk:"security_group_id"`
+	Direction             types.String `tfsdk:"direction"`
+	Protocol              types.String `tfsdk:"protocol"`
+	PortRangeMin          types.Int64  `tfsdk:"port_range_min"`
+	PortRangeMax          types.Int64  `tfsdk:"port_range_max"`
+	CIDR                  types.String `tfsdk:"cidr"`
+	SourceSecurityGroupID types.Int64  `tfsdk:"source_security_group_id"`
+	Description           types.String `tfsdk:"description"`
+}
+
+func NewSecurityGroupRuleResource() resource.Resource {
+	return &SecurityGroupRuleResource{}
+}
+
+func (r *SecurityGroupRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rule"
+}
+
+func (r *SecurityGroupRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single rule of a ProData security group. Rules cannot be modified; " +
+			"changing any attribute replaces only this rule, leaving the group and its other rules untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the security group the rule belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "The direction of traffic the rule applies to: `ingress` or `egress`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ingress", "egress"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol the rule applies to: `tcp`, `udp`, `icmp` or `any`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp", "icmp", "any"),
+				},
+			},
+			"port_range_min": schema.Int64Attribute{
+				MarkdownDescription: "The first port of the range. Only valid for `tcp` and `udp`; omit both port attributes to match all ports.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"port_range_max": schema.Int64Attribute{
+				MarkdownDescription: "The last port of the range. Set it equal to `port_range_min` for a single port.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR block traffic is allowed from (ingress) or to (egress), e.g. `0.0.0.0/0`. Conflicts with `source_security_group_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_security_group_id": schema.Int64Attribute{
+				MarkdownDescription: "Allow traffic from (ingress) or to (egress) the members of this security group. Conflicts with `cidr`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the rule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityGroupRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("cidr"),
+			path.MatchRoot("source_security_group_id"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("port_range_min"),
+			path.MatchRoot("port_range_max"),
+		),
+	}
+}
+
+func (r *SecurityGroupRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CIDR.IsNull() && !data.CIDR.IsUnknown() {
+		if _, _, err := net.ParseCIDR(data.CIDR.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cidr"), "Invalid CIDR",
+				fmt.Sprintf("%q is not a valid CIDR block (e.g., 10.0.0.0/24).", data.CIDR.ValueString()))
+		}
+	}
+
+	hasPorts := !data.PortRangeMin.IsNull() || !data.PortRangeMax.IsNull()
+	if protocol := data.Protocol.ValueString(); hasPorts && (protocol == "icmp" || protocol == "any") {
+		resp.Diagnostics.AddAttributeError(path.Root("port_range_min"), "Invalid Port Range",
+			fmt.Sprintf("Port ranges can only be set for the tcp and udp protocols, not %q.", protocol))
+		return
+	}
+
+	if data.PortRangeMin.IsUnknown() || data.PortRangeMax.IsUnknown() || data.PortRangeMin.IsNull() || data.PortRangeMax.IsNull() {
+		return
+	}
+	if data.PortRangeMin.ValueInt64() > data.PortRangeMax.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("port_range_max"), "Invalid Port Range",
+			fmt.Sprintf("port_range_max (%d) must be greater than or equal to port_range_min (%d).",
+				data.PortRangeMax.ValueInt64(), data.PortRangeMin.ValueInt64()))
+	}
+}
+
+func (r *SecurityGroupRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SecurityGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	createReq := client.CreateSecurityGroupRuleRequest{
+		Region:                region,
+		ProjectID:             projectID,
+		Direction:             data.Direction.ValueString(),
+		Protocol:              data.Protocol.ValueString(),
+		PortRangeMin:          data.PortRangeMin.ValueInt64Pointer(),
+		PortRangeMax:          data.PortRangeMax.ValueInt64Pointer(),
+		CIDR:                  data.CIDR.ValueString(),
+		SourceSecurityGroupID: data.SourceSecurityGroupID.ValueInt64Pointer(),
+		Description:           data.Description.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Creating security group rule", map[string]any{
+		"security_group_id": groupID,
+		"direction":         createReq.Direction,
+		"protocol":          createReq.Protocol,
+		"region":            createReq.Region,
+		"project_id":        createReq.ProjectID,
+	})
+
+	rule, err := r.client.CreateSecurityGroupRule(ctx, groupID, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Security Group Rule", err)
+		return
+	}
+
+	data.ID = types.Int64Value(rule.ID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+
+	tflog.Debug(ctx, "Created security group rule", map[string]any{
+		"id":                rule.ID,
+		"security_group_id": groupID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	ruleID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading security group rule", map[string]any{
+		"id":                ruleID,
+		"security_group_id": groupID,
+		"region":            opts.Region,
+		"project_id":        opts.ProjectID,
+	})
+
+	group, err := r.client.GetSecurityGroup(ctx, groupID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Security group not found, removing rule from state", map[string]any{
+				"id":                ruleID,
+				"security_group_id": groupID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Security Group Rule", err)
+		return
+	}
+
+	var rule *client.SecurityGroupRule
+	for i := range group.Rules {
+		if group.Rules[i].ID == ruleID {
+			rule = &group.Rules[i]
+			break
+		}
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "Security group rule not found, removing from state", map[string]any{
+			"id":                ruleID,
+			"security_group_id": groupID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Direction = types.StringValue(rule.Direction)
+	data.Protocol = types.StringValue(rule.Protocol)
+	data.PortRangeMin = types.Int64PointerValue(rule.PortRangeMin)
+	data.PortRangeMax = types.Int64PointerValue(rule.PortRangeMax)
+	data.SourceSecurityGroupID = types.Int64PointerValue(rule.SourceSecurityGroupID)
+	data.Description = types.StringValue(rule.Description)
+	if rule.CIDR != "" {
+		data.CIDR = types.StringValue(rule.CIDR)
+	} else {
+		data.CIDR = types.StringNull()
+	}
+
+	tflog.Debug(ctx, "Read security group rule", map[string]any{
+		"id":                ruleID,
+		"security_group_id": groupID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SecurityGroupRuleResourceModel
+
+	// Every attribute forces replacement, so there is nothing to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	groupID := data.SecurityGroupID.ValueInt64()
+	ruleID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting security group rule", map[string]any{
+		"id":                ruleID,
+		"security_group_id": groupID,
+		"region":            opts.Region,
+		"project_id":        opts.ProjectID,
+	})
+
+	err := r.client.DeleteSecurityGroupRule(ctx, groupID, ruleID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Security Group Rule", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted security group rule", map[string]any{
+		"id":                ruleID,
+		"security_group_id": groupID,
+	})
+}
+
+// ImportState accepts `<security_group_id>/<rule_id>` or `<region>/<project_id>/<security_group_id>/<rule_id>`.
+func (r *SecurityGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPairResource(ctx, r.client, req, resp, "security_group_id", "id")
+}
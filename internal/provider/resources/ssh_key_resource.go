@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+	"terraform-provider-prodata/internal/provider/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SSHKeyResource{}
+	_ resource.ResourceWithConfigure   = &SSHKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &SSHKeyResource{}
+	_ resource.ResourceWithImportState = &SSHKeyResource{}
+)
+
+type SSHKeyResource struct {
+	client *client.Client
+}
+
+type SSHKeyResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+}
+
+func NewSSHKeyResource() resource.Resource {
+	return &SSHKeyResource{}
+}
+
+func (r *SSHKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *SSHKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("SSH key")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ProData SSH key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the SSH key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SSH key. This and `tags` are the only attributes that can be updated in-place.",
+				Required:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key in OpenSSH format (e.g., the contents of `~/.ssh/id_ed25519.pub`). Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.OpenSSHPublicKey(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The fingerprint of the public key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+	}
+}
+
+func (r *SSHKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *SSHKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
+func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateSSHKeyRequest{
+		Name:      data.Name.ValueString(),
+		PublicKey: strings.TrimSpace(data.PublicKey.ValueString()),
+		Tags:      tags,
+	}
+
+	tflog.Debug(ctx, "Creating SSH key", map[string]any{
+		"name": createReq.Name,
+	})
+
+	key, err := r.client.CreateSSHKey(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create SSH Key", err)
+		return
+	}
+
+	data.ID = types.Int64Value(key.ID)
+	data.Name = types.StringValue(key.Name)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	data.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Created SSH key", map[string]any{
+		"id":          key.ID,
+		"fingerprint": key.Fingerprint,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading SSH key", map[string]any{
+		"id": keyID,
+	})
+
+	key, err := r.client.GetSSHKey(ctx, keyID, nil)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "SSH key not found, removing from state", map[string]any{
+				"id": keyID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read SSH Key", err)
+		return
+	}
+
+	data.Name = types.StringValue(key.Name)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+
+	// The API may drop the comment or surrounding whitespace; keep the configured
+	// value as long as it is the same key.
+	if data.PublicKey.IsNull() || !sameSSHPublicKey(data.PublicKey.ValueString(), key.PublicKey) {
+		data.PublicKey = types.StringValue(key.PublicKey)
+	}
+	resp.Diagnostics.Append(readTags(ctx, r.client, key.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read SSH key", map[string]any{
+		"id":   keyID,
+		"name": key.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SSHKeyResourceModel
+	var state SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := state.ID.ValueInt64()
+
+	// Only name and tags can be updated via API
+	updateReq := client.UpdateSSHKeyRequest{
+		Name: plan.Name.ValueString(),
+		Tags: tags,
+	}
+
+	tflog.Debug(ctx, "Updating SSH key", map[string]any{
+		"id":   keyID,
+		"name": updateReq.Name,
+	})
+
+	key, err := r.client.UpdateSSHKey(ctx, keyID, updateReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update SSH Key", err)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(key.Name)
+	plan.Fingerprint = state.Fingerprint
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated SSH key", map[string]any{
+		"id":   keyID,
+		"name": key.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting SSH key", map[string]any{
+		"id": keyID,
+	})
+
+	err := r.client.DeleteSSHKey(ctx, keyID)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete SSH Key", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted SSH key", map[string]any{
+		"id": keyID,
+	})
+}
+
+// ImportState accepts either the numeric ID of the key or its fingerprint.
+func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Looking up SSH key by fingerprint", map[string]any{
+		"fingerprint": req.ID,
+	})
+
+	keys, err := r.client.GetSSHKeys(ctx, nil)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Import SSH Key", err)
+		return
+	}
+
+	for _, key := range keys {
+		if key.Fingerprint == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), key.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"SSH Key Not Found",
+		fmt.Sprintf("No SSH key with ID or fingerprint %q exists.", req.ID),
+	)
+}
+
+// sameSSHPublicKey reports whether two authorized_keys lines hold the same key,
+// ignoring the comment.
+func sameSSHPublicKey(a, b string) bool {
+	fa, fb := strings.Fields(a), strings.Fields(b)
+	if len(fa) < 2 || len(fb) < 2 {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return fa[0] == fb[0] && fa[1] == fb[1]
+}
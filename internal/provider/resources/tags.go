@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-prodata/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsAttributes returns the `tags` and `tags_all` attributes of a taggable
+// resource. noun is the singular of the managed object (e.g. "volume").
+func tagsAttributes(noun string) (tags, tagsAll schema.Attribute) {
+	tags = schema.MapAttribute{
+		MarkdownDescription: "Tags to assign to the " + noun + ". Tags with the same key as one of the provider's `default_tags` take precedence over it.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+	tagsAll = schema.MapAttribute{
+		MarkdownDescription: "All tags of the " + noun + ", including those inherited from the provider's `default_tags`.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	return tags, tagsAll
+}
+
+// mergeTags returns the client's default tags overlaid with tags. known is false
+// when tags or one of its values is not known yet.
+func mergeTags(ctx context.Context, c *client.Client, tags types.Map) (merged map[string]string, known bool, diags diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return nil, false, nil
+	}
+
+	merged = maps.Clone(c.DefaultTags)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+
+	var values map[string]types.String
+	diags.Append(tags.ElementsAs(ctx, &values, false)...)
+	for k, v := range values {
+		if v.IsUnknown() {
+			return nil, false, diags
+		}
+		merged[k] = v.ValueString()
+	}
+	return merged, true, diags
+}
+
+// resourceTags returns the tags to send for a resource with the given tags, and
+// the matching tags_all value.
+func resourceTags(ctx context.Context, c *client.Client, tags types.Map) (map[string]string, types.Map, diag.Diagnostics) {
+	merged, _, diags := mergeTags(ctx, c, tags)
+	return merged, tagsValue(merged), diags
+}
+
+// modifyPlanTags plans tags_all as the provider's default_tags overlaid with tags,
+// so that a change to either shows up in the plan.
+func modifyPlanTags(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || c == nil {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	merged, known, diags := mergeTags(ctx, c, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.MapUnknown(types.StringType)
+	if known {
+		tagsAll = tagsValue(merged)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// readTags sets tags and tags_all from the tags reported by the API. A tag that
+// matches one of the provider's default_tags is left out of tags unless tags
+// already holds it, so inherited tags do not show up as configuration drift.
+func readTags(ctx context.Context, c *client.Client, apiTags map[string]string, tags, tagsAll *types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var current map[string]types.String
+	if !tags.IsNull() && !tags.IsUnknown() {
+		diags.Append(tags.ElementsAs(ctx, &current, false)...)
+	}
+
+	own := map[string]string{}
+	for k, v := range apiTags {
+		if dv, ok := c.DefaultTags[k]; ok && dv == v {
+			if _, set := current[k]; !set {
+				continue
+			}
+		}
+		own[k] = v
+	}
+
+	if len(own) > 0 || !tags.IsNull() {
+		*tags = tagsValue(own)
+	}
+	*tagsAll = tagsValue(apiTags)
+	return diags
+}
+
+// tagsValue converts tags to a map value. A nil map becomes an empty map.
+func tagsValue(tags map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(tags))
+	for k, v := range tags {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
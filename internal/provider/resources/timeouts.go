@@ -0,0 +1,11 @@
+package resources
+
+import "time"
+
+// Default timeouts for waiting on asynchronous operations, overridable per resource
+// through the `timeouts` block.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
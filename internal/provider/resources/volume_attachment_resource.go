@@ -0,0 +1,362 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &VolumeAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &VolumeAttachmentResource{}
+	_ resource.ResourceWithImportState = &VolumeAttachmentResource{}
+)
+
+type VolumeAttachmentResource struct {
+	client *client.Client
+}
+
+type VolumeAttachmentResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Region     types.String   `tfsdk:"region"`
+	ProjectID  types.Int64    `tfsdk:"project_id"`
+	VolumeID   types.Int64    `tfsdk:"volume_id"`
+	InstanceID types.Int64    `tfsdk:"instance_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVolumeAttachmentResource() resource.Resource {
+	return &VolumeAttachmentResource{}
+}
+
+func (r *VolumeAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_attachment"
+}
+
+func (r *VolumeAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a ProData volume to an instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the attachment in the form `<volume_id>/<instance_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID override. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the volume to attach. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the instance to attach the volume to. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *VolumeAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *VolumeAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+	opts := &client.RequestOpts{Region: region, ProjectID: projectID}
+
+	volumeID := data.VolumeID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Attaching volume", map[string]any{
+		"volume_id":   volumeID,
+		"instance_id": instanceID,
+		"region":      region,
+		"project_id":  projectID,
+	})
+
+	volume, err := r.client.GetVolume(ctx, volumeID, opts)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Attach Volume", err)
+		return
+	}
+
+	switch {
+	case volume.AttachedID == nil:
+		_, err := r.client.AttachVolume(ctx, volumeID, client.AttachVolumeRequest{
+			Region:     region,
+			ProjectID:  projectID,
+			InstanceID: instanceID,
+		})
+		if client.IsConflict(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("volume_id"), "Volume Already In Use",
+				fmt.Sprintf("Volume %d could not be attached to instance %d because it is already attached to another instance. "+
+					"Detach it first, or remove the prodata_volume_attachment that manages it.\n\n%s",
+					volumeID, instanceID, err))
+			return
+		}
+		if err != nil {
+			diagutil.AddAPIError(&resp.Diagnostics, "Unable to Attach Volume", err)
+			return
+		}
+	case *volume.AttachedID == instanceID:
+		tflog.Debug(ctx, "Volume is already attached to the instance", map[string]any{
+			"volume_id":   volumeID,
+			"instance_id": instanceID,
+		})
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("volume_id"), "Volume Already In Use",
+			fmt.Sprintf("Volume %d is attached to instance %d and cannot be attached to instance %d. "+
+				"Detach it from instance %d first, or remove the prodata_volume_attachment that manages it.",
+				volumeID, *volume.AttachedID, instanceID, *volume.AttachedID))
+		return
+	}
+
+	data.ID = types.StringValue(volumeAttachmentID(volumeID, instanceID))
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+
+	// Save the attachment before waiting so that a failed wait taints the resource.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.WaitForVolumeAttachment(ctx, volumeID, instanceID, opts, createTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Attach Volume", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Attached volume", map[string]any{
+		"volume_id":   volumeID,
+		"instance_id": instanceID,
+	})
+}
+
+func (r *VolumeAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	volumeID := data.VolumeID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading volume attachment", map[string]any{
+		"volume_id":   volumeID,
+		"instance_id": instanceID,
+		"region":      opts.Region,
+		"project_id":  opts.ProjectID,
+	})
+
+	volume, err := r.client.GetVolume(ctx, volumeID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Volume not found, removing attachment from state", map[string]any{
+				"volume_id": volumeID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Volume Attachment", err)
+		return
+	}
+
+	if volume.AttachedID == nil || *volume.AttachedID != instanceID {
+		tflog.Warn(ctx, "Volume is no longer attached to the instance, removing attachment from state", map[string]any{
+			"volume_id":   volumeID,
+			"instance_id": instanceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(volumeAttachmentID(volumeID, instanceID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VolumeAttachmentResourceModel
+
+	// All attributes force replacement; only the timeouts can change in place.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VolumeAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	volumeID := data.VolumeID.ValueInt64()
+	instanceID := data.InstanceID.ValueInt64()
+
+	// The volume may have been moved to another instance outside Terraform; only
+	// detach it from the instance this attachment manages.
+	volume, err := r.client.GetVolume(ctx, volumeID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Detach Volume", err)
+		return
+	}
+	if volume.AttachedID == nil || *volume.AttachedID != instanceID {
+		tflog.Warn(ctx, "Volume is no longer attached to the instance, skipping detach", map[string]any{
+			"volume_id":   volumeID,
+			"instance_id": instanceID,
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching volume", map[string]any{
+		"volume_id":   volumeID,
+		"instance_id": instanceID,
+		"region":      opts.Region,
+		"project_id":  opts.ProjectID,
+	})
+
+	err = r.client.DetachVolume(ctx, volumeID, client.DetachVolumeRequest{
+		Region:    opts.Region,
+		ProjectID: opts.ProjectID,
+	})
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Detach Volume", err)
+		return
+	}
+
+	_, err = r.client.WaitForVolumeAttachment(ctx, volumeID, 0, opts, deleteTimeout)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Detach Volume", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Detached volume", map[string]any{
+		"volume_id":   volumeID,
+		"instance_id": instanceID,
+	})
+}
+
+// ImportState accepts `<volume_id>/<instance_id>` or `<region>/<project_id>/<volume_id>/<instance_id>`.
+func (r *VolumeAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsed, ok := importPairResource(ctx, r.client, req, resp, "volume_id", "instance_id")
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), volumeAttachmentID(parsed.First, parsed.Second))...)
+}
+
+func volumeAttachmentID(volumeID, instanceID int64) string {
+	return fmt.Sprintf("%d/%d", volumeID, instanceID)
+}
@@ -5,19 +5,27 @@ import (
 	"fmt"
 
 	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &VolumeResource{}
-	_ resource.ResourceWithConfigure = &VolumeResource{}
+	_ resource.Resource                = &VolumeResource{}
+	_ resource.ResourceWithConfigure   = &VolumeResource{}
+	_ resource.ResourceWithModifyPlan  = &VolumeResource{}
+	_ resource.ResourceWithImportState = &VolumeResource{}
 )
 
 type VolumeResource struct {
@@ -25,12 +33,16 @@ type VolumeResource struct {
 }
 
 type VolumeResourceModel struct {
-	ID        types.Int64  `tfsdk:"id"`
-	Region    types.String `tfsdk:"region"`
-	ProjectID types.Int64  `tfsdk:"project_id"`
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Size      types.Int64  `tfsdk:"size"`
+	ID         types.Int64    `tfsdk:"id"`
+	Region     types.String   `tfsdk:"region"`
+	ProjectID  types.Int64    `tfsdk:"project_id"`
+	Name       types.String   `tfsdk:"name"`
+	Type       types.String   `tfsdk:"type"`
+	Size       types.Int64    `tfsdk:"size"`
+	SnapshotID types.Int64    `tfsdk:"snapshot_id"`
+	Tags       types.Map      `tfsdk:"tags"`
+	TagsAll    types.Map      `tfsdk:"tags_all"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewVolumeResource() resource.Resource {
@@ -42,6 +54,8 @@ func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("volume")
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a ProData volume.",
 
@@ -81,14 +95,40 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("HDD", "SSD"),
+				},
 			},
 			"size": schema.Int64Attribute{
-				MarkdownDescription: "The size of the volume in GB. Changing this forces a new resource.",
+				MarkdownDescription: "The size of the volume in GB. Must be within the limits of the volume type in the region. Growing the volume is done in place; shrinking it forces a new resource.",
 				Required:            true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64RequiresReplaceIfDecreased(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"snapshot_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of a snapshot to restore the volume from. Changing this forces a new resource. " +
+					"Removing it from the configuration keeps the volume and its data.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64CreateOnly(),
 				},
 			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -110,6 +150,91 @@ func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = c
 }
 
+// ModifyPlan plans tags_all and checks the volume type and size against the
+// region's volume type catalogue, so that an unavailable type or an out-of-range
+// size fails the plan.
+func (r *VolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	modifyPlanTags(ctx, r.client, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan VolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Type.IsUnknown() || plan.Size.IsUnknown() {
+		return
+	}
+
+	// Existing volumes are only checked when their size changes.
+	if !req.State.Raw.IsNull() {
+		var state VolumeResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.Type.Equal(plan.Type) && state.Size.Equal(plan.Size) {
+			return
+		}
+	}
+
+	// An unknown region is left to the client, which falls back to the provider's
+	// default region like Create does.
+	opts := &client.RequestOpts{}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		opts.Region = plan.Region.ValueString()
+	}
+
+	volumeType, err := r.client.VolumeType(ctx, plan.Type.ValueString(), opts)
+	if err != nil {
+		// The catalogue is advisory; the API still validates the request on apply.
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Volume type catalogue is not available, skipping size validation")
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Volume Type",
+			fmt.Sprintf("The volume type catalogue could not be read, so type and size are not checked during plan: %s", err),
+		)
+		return
+	}
+
+	region := opts.Region
+	if region == "" {
+		region = r.client.Region
+	}
+
+	if volumeType == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Volume Type Not Available",
+			fmt.Sprintf("Volume type %q is not offered in region %s.", plan.Type.ValueString(), region))
+		return
+	}
+
+	size := plan.Size.ValueInt64()
+	if (volumeType.MinSize > 0 && size < volumeType.MinSize) || (volumeType.MaxSize > 0 && size > volumeType.MaxSize) {
+		resp.Diagnostics.AddAttributeError(path.Root("size"), "Invalid Volume Size",
+			fmt.Sprintf("%s volumes in region %s must be %s, got %d.",
+				volumeType.Name, region, sizeRange(volumeType.MinSize, volumeType.MaxSize), size))
+	}
+}
+
+// sizeRange describes the allowed sizes of a volume type, where a zero limit is
+// not enforced.
+func sizeRange(minSize, maxSize int64) string {
+	switch {
+	case minSize > 0 && maxSize > 0:
+		return fmt.Sprintf("between %d and %d GB", minSize, maxSize)
+	case minSize > 0:
+		return fmt.Sprintf("at least %d GB", minSize)
+	default:
+		return fmt.Sprintf("at most %d GB", maxSize)
+	}
+}
+
 func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VolumeResourceModel
 
@@ -118,6 +243,18 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Use provider defaults if not specified in resource
 	region := data.Region.ValueString()
 	if region == "" {
@@ -134,25 +271,48 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		Name:      data.Name.ValueString(),
 		Type:      data.Type.ValueString(),
 		Size:      data.Size.ValueInt64(),
+		Tags:      tags,
+	}
+	data.SnapshotID = nullIfUnknown(data.SnapshotID)
+	if !data.SnapshotID.IsNull() {
+		createReq.SnapshotID = data.SnapshotID.ValueInt64()
 	}
 
 	tflog.Debug(ctx, "Creating volume", map[string]any{
-		"name":       createReq.Name,
-		"region":     createReq.Region,
-		"project_id": createReq.ProjectID,
-		"type":       createReq.Type,
-		"size":       createReq.Size,
+		"name":        createReq.Name,
+		"region":      createReq.Region,
+		"project_id":  createReq.ProjectID,
+		"type":        createReq.Type,
+		"size":        createReq.Size,
+		"snapshot_id": createReq.SnapshotID,
 	})
 
 	volume, err := r.client.CreateVolume(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Create Volume", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Volume", err)
 		return
 	}
 
 	data.ID = types.Int64Value(volume.ID)
 	data.Region = types.StringValue(region)
 	data.ProjectID = types.Int64Value(projectID)
+	data.Name = types.StringValue(volume.Name)
+	data.Type = types.StringValue(volume.Type)
+	data.Size = types.Int64Value(volume.Size)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err = r.client.WaitForVolumeReady(ctx, volume.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Volume", err)
+		return
+	}
+
 	data.Name = types.StringValue(volume.Name)
 	data.Type = types.StringValue(volume.Type)
 	data.Size = types.Int64Value(volume.Size)
@@ -192,13 +352,21 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	volume, err := r.client.GetVolume(ctx, volumeID, opts)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Volume", err.Error())
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Volume not found, removing from state", map[string]any{
+				"id": volumeID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Volume", err)
 		return
 	}
 
 	data.Name = types.StringValue(volume.Name)
 	data.Type = types.StringValue(volume.Type)
 	data.Size = types.Int64Value(volume.Size)
+	resp.Diagnostics.Append(readTags(ctx, r.client, volume.Tags, &data.Tags, &data.TagsAll)...)
 
 	tflog.Debug(ctx, "Read volume", map[string]any{
 		"id":   volumeID,
@@ -218,11 +386,27 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	volumeID := state.ID.ValueInt64()
 
-	// Only name can be updated via API, region and projectId in request body
+	// Only name, size and tags can be updated via API, region and projectId in request body
 	updateReq := client.UpdateVolumeRequest{
 		Name: plan.Name.ValueString(),
+		Tags: tags,
+	}
+	if plan.Size.ValueInt64() != state.Size.ValueInt64() {
+		updateReq.Size = plan.Size.ValueInt64()
 	}
 	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
 		updateReq.Region = plan.Region.ValueString()
@@ -234,13 +418,25 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Debug(ctx, "Updating volume", map[string]any{
 		"id":         volumeID,
 		"name":       updateReq.Name,
+		"size":       updateReq.Size,
 		"region":     updateReq.Region,
 		"project_id": updateReq.ProjectID,
 	})
 
 	volume, err := r.client.UpdateVolume(ctx, volumeID, updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Update Volume", err.Error())
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Volume", err)
+		return
+	}
+
+	waitOpts := &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}
+	if updateReq.Size != 0 {
+		volume, err = r.client.WaitForVolumeResized(ctx, volumeID, updateReq.Size, waitOpts, updateTimeout)
+	} else {
+		volume, err = r.client.WaitForVolumeReady(ctx, volumeID, waitOpts, updateTimeout)
+	}
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Volume", err)
 		return
 	}
 
@@ -248,6 +444,8 @@ func (r *VolumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.Name = types.StringValue(volume.Name)
 	plan.Type = types.StringValue(volume.Type)
 	plan.Size = types.Int64Value(volume.Size)
+	plan.SnapshotID = nullIfUnknown(plan.SnapshotID)
+	plan.TagsAll = tagsAll
 
 	tflog.Debug(ctx, "Updated volume", map[string]any{
 		"id":   volumeID,
@@ -265,6 +463,12 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Only set opts if explicitly provided in resource (overrides provider defaults)
 	opts := &client.RequestOpts{}
 	if !data.Region.IsNull() && !data.Region.IsUnknown() {
@@ -283,8 +487,13 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 
 	err := r.client.DeleteVolume(ctx, volumeID, opts)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Delete Volume", err.Error())
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Volume", err)
+		return
+	}
+
+	if err := r.client.WaitForVolumeDeleted(ctx, volumeID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Volume", err)
 		return
 	}
 
@@ -292,3 +501,7 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		"id": volumeID,
 	})
 }
+
+func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
@@ -0,0 +1,20 @@
+package resources
+
+import "testing"
+
+func TestSizeRange(t *testing.T) {
+	tests := []struct {
+		min, max int64
+		want     string
+	}{
+		{min: 10, max: 1000, want: "between 10 and 1000 GB"},
+		{min: 10, want: "at least 10 GB"},
+		{max: 4000, want: "at most 4000 GB"},
+	}
+
+	for _, tt := range tests {
+		if got := sizeRange(tt.min, tt.max); got != tt.want {
+			t.Errorf("sizeRange(%d, %d) = %q, want %q", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
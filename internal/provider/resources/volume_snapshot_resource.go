@@ -0,0 +1,403 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-prodata/internal/client"
+	"terraform-provider-prodata/internal/provider/diagutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &VolumeSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &VolumeSnapshotResource{}
+	_ resource.ResourceWithModifyPlan  = &VolumeSnapshotResource{}
+	_ resource.ResourceWithImportState = &VolumeSnapshotResource{}
+)
+
+type VolumeSnapshotResource struct {
+	client *client.Client
+}
+
+type VolumeSnapshotResourceModel struct {
+	ID        types.Int64    `tfsdk:"id"`
+	Region    types.String   `tfsdk:"region"`
+	ProjectID types.Int64    `tfsdk:"project_id"`
+	VolumeID  types.Int64    `tfsdk:"volume_id"`
+	Name      types.String   `tfsdk:"name"`
+	Size      types.Int64    `tfsdk:"size"`
+	Status    types.String   `tfsdk:"status"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	Tags      types.Map      `tfsdk:"tags"`
+	TagsAll   types.Map      `tfsdk:"tags_all"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVolumeSnapshotResource() resource.Resource {
+	return &VolumeSnapshotResource{}
+}
+
+func (r *VolumeSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot"
+}
+
+func (r *VolumeSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tags, tagsAll := tagsAttributes("snapshot")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a snapshot of a ProData volume.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The unique identifier of the snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region ID override. If not specified, uses the provider's default region.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Project ID where the snapshot will be created. If not specified, uses the provider's default project_id.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the volume to snapshot. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the snapshot. This and `tags` are the only attributes that can be updated in-place.",
+				Required:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the snapshot in GB.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the snapshot (e.g., `available`).",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the snapshot was taken, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tags,
+			"tags_all": tagsAll,
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *VolumeSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan plans tags_all from tags and the provider's default_tags.
+func (r *VolumeSnapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTags(ctx, r.client, req, resp)
+}
+
+func (r *VolumeSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VolumeSnapshotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use provider defaults if not specified in resource
+	region := data.Region.ValueString()
+	if region == "" {
+		region = r.client.Region
+	}
+	projectID := data.ProjectID.ValueInt64()
+	if projectID == 0 {
+		projectID = r.client.ProjectID
+	}
+
+	createReq := client.CreateSnapshotRequest{
+		Region:    region,
+		ProjectID: projectID,
+		VolumeID:  data.VolumeID.ValueInt64(),
+		Name:      data.Name.ValueString(),
+		Tags:      tags,
+	}
+
+	tflog.Debug(ctx, "Creating volume snapshot", map[string]any{
+		"name":       createReq.Name,
+		"volume_id":  createReq.VolumeID,
+		"region":     createReq.Region,
+		"project_id": createReq.ProjectID,
+	})
+
+	snapshot, err := r.client.CreateSnapshot(ctx, createReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Volume Snapshot", err)
+		return
+	}
+
+	data.ID = types.Int64Value(snapshot.ID)
+	data.Region = types.StringValue(region)
+	data.ProjectID = types.Int64Value(projectID)
+	applySnapshot(&data, snapshot)
+	data.TagsAll = tagsAll
+
+	// Save the new ID before waiting so that a failed wait taints the resource instead of orphaning it.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err = r.client.WaitForSnapshotReady(ctx, snapshot.ID, &client.RequestOpts{Region: region, ProjectID: projectID}, createTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Create Volume Snapshot", err)
+		return
+	}
+
+	applySnapshot(&data, snapshot)
+
+	tflog.Debug(ctx, "Created volume snapshot", map[string]any{
+		"id":   snapshot.ID,
+		"name": snapshot.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VolumeSnapshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	snapshotID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading volume snapshot", map[string]any{
+		"id":         snapshotID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	snapshot, err := r.client.GetSnapshot(ctx, snapshotID, opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Volume snapshot not found, removing from state", map[string]any{
+				"id": snapshotID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Read Volume Snapshot", err)
+		return
+	}
+
+	applySnapshot(&data, snapshot)
+	resp.Diagnostics.Append(readTags(ctx, r.client, snapshot.Tags, &data.Tags, &data.TagsAll)...)
+
+	tflog.Debug(ctx, "Read volume snapshot", map[string]any{
+		"id":   snapshotID,
+		"name": snapshot.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VolumeSnapshotResourceModel
+	var state VolumeSnapshotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, tagsAll, diags := resourceTags(ctx, r.client, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotID := state.ID.ValueInt64()
+
+	// Only name and tags can be updated via API, region and projectId in request body
+	updateReq := client.UpdateSnapshotRequest{
+		Name: plan.Name.ValueString(),
+		Tags: tags,
+	}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		updateReq.Region = plan.Region.ValueString()
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		updateReq.ProjectID = plan.ProjectID.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Updating volume snapshot", map[string]any{
+		"id":         snapshotID,
+		"name":       updateReq.Name,
+		"region":     updateReq.Region,
+		"project_id": updateReq.ProjectID,
+	})
+
+	_, err := r.client.UpdateSnapshot(ctx, snapshotID, updateReq)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Volume Snapshot", err)
+		return
+	}
+
+	snapshot, err := r.client.WaitForSnapshotReady(ctx, snapshotID, &client.RequestOpts{Region: updateReq.Region, ProjectID: updateReq.ProjectID}, updateTimeout)
+	if err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Update Volume Snapshot", err)
+		return
+	}
+
+	plan.ID = state.ID
+	applySnapshot(&plan, snapshot)
+	plan.TagsAll = tagsAll
+
+	tflog.Debug(ctx, "Updated volume snapshot", map[string]any{
+		"id":   snapshotID,
+		"name": snapshot.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VolumeSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VolumeSnapshotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only set opts if explicitly provided in resource (overrides provider defaults)
+	opts := &client.RequestOpts{}
+	if !data.Region.IsNull() && !data.Region.IsUnknown() {
+		opts.Region = data.Region.ValueString()
+	}
+	if !data.ProjectID.IsNull() && !data.ProjectID.IsUnknown() {
+		opts.ProjectID = data.ProjectID.ValueInt64()
+	}
+
+	snapshotID := data.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Deleting volume snapshot", map[string]any{
+		"id":         snapshotID,
+		"region":     opts.Region,
+		"project_id": opts.ProjectID,
+	})
+
+	err := r.client.DeleteSnapshot(ctx, snapshotID, opts)
+	if err != nil && !client.IsNotFound(err) {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Volume Snapshot", err)
+		return
+	}
+
+	if err := r.client.WaitForSnapshotDeleted(ctx, snapshotID, opts, deleteTimeout); err != nil {
+		diagutil.AddAPIError(&resp.Diagnostics, "Unable to Delete Volume Snapshot", err)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted volume snapshot", map[string]any{
+		"id": snapshotID,
+	})
+}
+
+func (r *VolumeSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRegionalResource(ctx, r.client, req, resp)
+}
+
+// applySnapshot copies the API representation of a snapshot into the model.
+func applySnapshot(data *VolumeSnapshotResourceModel, snapshot *client.Snapshot) {
+	data.VolumeID = types.Int64Value(snapshot.VolumeID)
+	data.Name = types.StringValue(snapshot.Name)
+	data.Size = types.Int64Value(snapshot.Size)
+	data.Status = types.StringValue(snapshot.Status)
+	data.CreatedAt = types.StringValue(snapshot.CreatedAt)
+}
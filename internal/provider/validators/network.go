@@ -0,0 +1,182 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateIPv4Ranges are the RFC 1918 blocks a local network may be carved from.
+var privateIPv4Ranges = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+}
+
+var _ validator.String = privateIPv4CIDRValidator{}
+
+type privateIPv4CIDRValidator struct {
+	minBits int
+	maxBits int
+}
+
+// PrivateIPv4CIDR checks that a string is an IPv4 network in CIDR notation, with no
+// host bits set, inside a private range and with a prefix length between minBits
+// and maxBits inclusive.
+func PrivateIPv4CIDR(minBits, maxBits int) validator.String {
+	return privateIPv4CIDRValidator{minBits: minBits, maxBits: maxBits}
+}
+
+func (v privateIPv4CIDRValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a private IPv4 network in CIDR notation (10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16) with a prefix length between /%d and /%d", v.minBits, v.maxBits)
+}
+
+func (v privateIPv4CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v privateIPv4CIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := v.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%s: %s.", v.Description(ctx), err),
+		)
+	}
+}
+
+func (v privateIPv4CIDRValidator) parse(raw string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(raw)
+	if err != nil || !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("%q is not an IPv4 CIDR block", raw)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set, the network address is %s", raw, masked)
+	}
+	if prefix.Bits() < v.minBits || prefix.Bits() > v.maxBits {
+		return netip.Prefix{}, fmt.Errorf("prefix length /%d is outside the allowed range", prefix.Bits())
+	}
+	for _, r := range privateIPv4Ranges {
+		if r.Bits() <= prefix.Bits() && r.Contains(prefix.Addr()) {
+			return prefix, nil
+		}
+	}
+	return netip.Prefix{}, fmt.Errorf("%s is not within a private address range", prefix)
+}
+
+// HostAddressError returns why ip cannot be given to a host on the cidr network,
+// or nil when it can. The network and broadcast addresses are reserved.
+func HostAddressError(ip, cidr string) error {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !addr.Is4() {
+		return fmt.Errorf("%q is not a valid IPv4 address", ip)
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("the network CIDR %q could not be parsed", cidr)
+	}
+	prefix = prefix.Masked()
+
+	if !prefix.Contains(addr) {
+		return fmt.Errorf("%s is outside %s", addr, prefix)
+	}
+	// /31 and /32 networks have no network or broadcast address (RFC 3021).
+	if prefix.Bits() >= 31 {
+		return nil
+	}
+	if addr == prefix.Addr() {
+		return fmt.Errorf("%s is the network address of %s", addr, prefix)
+	}
+	if addr == lastAddr(prefix) {
+		return fmt.Errorf("%s is the broadcast address of %s", addr, prefix)
+	}
+	return nil
+}
+
+// lastAddr returns the highest address in an IPv4 prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	a := prefix.Addr().As4()
+	hostBits := 32 - prefix.Bits()
+	for i := 3; i >= 0 && hostBits > 0; i-- {
+		n := min(hostBits, 8)
+		a[i] |= byte(1<<n - 1)
+		hostBits -= n
+	}
+	return netip.AddrFrom4(a)
+}
+
+var _ resource.ConfigValidator = gatewayInCIDRValidator{}
+
+type gatewayInCIDRValidator struct {
+	cidr    path.Path
+	gateway path.Path
+}
+
+// GatewayInCIDR checks that the gateway attribute is a usable host address of the
+// network in the cidr attribute. A mismatch is reported on both attributes, since
+// either one may be the typo.
+func GatewayInCIDR(cidr, gateway path.Path) resource.ConfigValidator {
+	return gatewayInCIDRValidator{cidr: cidr, gateway: gateway}
+}
+
+func (v gatewayInCIDRValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s must be a host address within %s, other than the network and broadcast addresses", v.gateway, v.cidr)
+}
+
+func (v gatewayInCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gatewayInCIDRValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cidr, gateway types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.cidr, &cidr)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.gateway, &gateway)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cidr.IsNull() || cidr.IsUnknown() || gateway.IsNull() || gateway.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(gateway.ValueString())
+	if err != nil || !addr.Is4() {
+		resp.Diagnostics.AddAttributeError(
+			v.gateway,
+			"Invalid Gateway",
+			fmt.Sprintf("%q is not a valid IPv4 address.", gateway.ValueString()),
+		)
+		return
+	}
+
+	// A malformed CIDR is reported by the attribute's own validator.
+	prefix, err := netip.ParsePrefix(cidr.ValueString())
+	if err != nil || !prefix.Addr().Is4() {
+		return
+	}
+
+	if err := HostAddressError(gateway.ValueString(), cidr.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			v.gateway,
+			"Invalid Gateway",
+			fmt.Sprintf("The gateway cannot be used on network %s: %s.", prefix.Masked(), err),
+		)
+		if !prefix.Masked().Contains(addr) {
+			resp.Diagnostics.AddAttributeError(
+				v.cidr,
+				"Invalid CIDR",
+				fmt.Sprintf("The CIDR block does not contain the gateway %s.", addr),
+			)
+		}
+	}
+}
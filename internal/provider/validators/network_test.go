@@ -0,0 +1,113 @@
+package validators
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestPrivateIPv4CIDRParse(t *testing.T) {
+	v := privateIPv4CIDRValidator{minBits: 16, maxBits: 29}
+
+	tests := []struct {
+		raw     string
+		wantErr string
+	}{
+		{raw: "10.0.0.0/16"},
+		{raw: "10.20.30.0/24"},
+		{raw: "172.16.0.0/16"},
+		{raw: "172.31.255.248/29"},
+		{raw: "192.168.1.0/24"},
+		{raw: "", wantErr: "is not an IPv4 CIDR block"},
+		{raw: "10.0.0.0", wantErr: "is not an IPv4 CIDR block"},
+		{raw: "10.0.0.0/33", wantErr: "is not an IPv4 CIDR block"},
+		{raw: "fd00::/64", wantErr: "is not an IPv4 CIDR block"},
+		{raw: "10.0.0.1/24", wantErr: "host bits set, the network address is 10.0.0.0/24"},
+		{raw: "10.0.0.0/8", wantErr: "prefix length /8 is outside the allowed range"},
+		{raw: "10.0.0.0/30", wantErr: "prefix length /30 is outside the allowed range"},
+		{raw: "172.32.0.0/16", wantErr: "not within a private address range"},
+		{raw: "8.8.8.0/24", wantErr: "not within a private address range"},
+		{raw: "192.169.0.0/16", wantErr: "not within a private address range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			_, err := v.parse(tt.raw)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("parse(%q) unexpected error: %v", tt.raw, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parse(%q) error = %v, want it to contain %q", tt.raw, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrivateIPv4CIDRParseWiderThanRange(t *testing.T) {
+	// A /8 starting in 172.16.0.0/12 still covers public addresses.
+	v := privateIPv4CIDRValidator{minBits: 8, maxBits: 32}
+	if _, err := v.parse("172.0.0.0/8"); err == nil {
+		t.Error("parse(172.0.0.0/8) succeeded, want an error")
+	}
+}
+
+func TestHostAddressError(t *testing.T) {
+	tests := []struct {
+		ip, cidr string
+		wantErr  string
+	}{
+		{ip: "10.0.0.1", cidr: "10.0.0.0/24"},
+		{ip: "10.0.0.254", cidr: "10.0.0.0/24"},
+		{ip: "10.0.0.1", cidr: "10.0.0.5/24"},
+		{ip: "10.0.0.0", cidr: "10.0.0.0/24", wantErr: "10.0.0.0 is the network address of 10.0.0.0/24"},
+		{ip: "10.0.0.255", cidr: "10.0.0.0/24", wantErr: "10.0.0.255 is the broadcast address of 10.0.0.0/24"},
+		{ip: "10.0.1.1", cidr: "10.0.0.0/24", wantErr: "10.0.1.1 is outside 10.0.0.0/24"},
+		{ip: "10.0.0.0", cidr: "10.0.0.0/31"},
+		{ip: "10.0.0.1", cidr: "10.0.0.0/31"},
+		{ip: "10.0.0.7", cidr: "10.0.0.7/32"},
+		{ip: "10.0.0", cidr: "10.0.0.0/24", wantErr: "is not a valid IPv4 address"},
+		{ip: "::1", cidr: "10.0.0.0/24", wantErr: "is not a valid IPv4 address"},
+		{ip: "10.0.0.1", cidr: "10.0.0.0", wantErr: "could not be parsed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip+" in "+tt.cidr, func(t *testing.T) {
+			err := HostAddressError(tt.ip, tt.cidr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("HostAddressError(%q, %q) unexpected error: %v", tt.ip, tt.cidr, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("HostAddressError(%q, %q) error = %v, want it to contain %q", tt.ip, tt.cidr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLastAddr(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{prefix: "10.0.0.0/8", want: "10.255.255.255"},
+		{prefix: "172.16.0.0/12", want: "172.31.255.255"},
+		{prefix: "192.168.4.0/22", want: "192.168.7.255"},
+		{prefix: "192.168.1.0/24", want: "192.168.1.255"},
+		{prefix: "192.168.1.8/29", want: "192.168.1.15"},
+		{prefix: "192.168.1.1/32", want: "192.168.1.1"},
+		{prefix: "0.0.0.0/0", want: "255.255.255.255"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			if got := lastAddr(netip.MustParsePrefix(tt.prefix)); got.String() != tt.want {
+				t.Errorf("lastAddr(%s) = %s, want %s", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
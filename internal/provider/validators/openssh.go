@@ -0,0 +1,115 @@
+// Package validators contains attribute validators shared by resources and data sources.
+package validators
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// openSSHKeyTypes lists the public key algorithms accepted by the API.
+var openSSHKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com",
+	"sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
+var _ validator.String = openSSHPublicKeyValidator{}
+
+type openSSHPublicKeyValidator struct{}
+
+// OpenSSHPublicKey checks that a string is a single public key in the OpenSSH
+// authorized_keys format: `<type> <base64 key> [comment]`.
+func OpenSSHPublicKey() validator.String {
+	return openSSHPublicKeyValidator{}
+}
+
+func (v openSSHPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be an OpenSSH public key (e.g. `ssh-ed25519 AAAA... user@host`)"
+}
+
+func (v openSSHPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v openSSHPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseOpenSSHPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH Public Key",
+			fmt.Sprintf("%s: %s.", v.Description(ctx), err),
+		)
+	}
+}
+
+func parseOpenSSHPublicKey(raw string) error {
+	key := strings.TrimSpace(raw)
+	// Pasted PEM and RFC 4716 blocks span several lines, so they are recognized
+	// first to give a more useful hint.
+	firstLine, _, _ := strings.Cut(key, "\n")
+	switch {
+	case strings.HasPrefix(firstLine, "-----BEGIN") && strings.Contains(firstLine, "PRIVATE KEY"):
+		return fmt.Errorf("got a private key; derive the public key with `ssh-keygen -y -f <key file>` or use the `.pub` file")
+	case strings.HasPrefix(firstLine, "-----BEGIN"):
+		return fmt.Errorf("got a PEM-encoded public key; convert it with `ssh-keygen -i -m PKCS8 -f <key file>` or use the `.pub` file")
+	case strings.HasPrefix(firstLine, "---- BEGIN SSH2 PUBLIC KEY"):
+		return fmt.Errorf("got an RFC 4716 public key; convert it with `ssh-keygen -i -f <key file>` or use the `.pub` file")
+	}
+	if strings.Contains(key, "\n") {
+		return fmt.Errorf("expected a single key, got multiple lines")
+	}
+
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return fmt.Errorf("expected `<type> <base64 key> [comment]`")
+	}
+
+	keyType := fields[0]
+	if !isOpenSSHKeyType(keyType) {
+		return fmt.Errorf("unsupported key type %q, expected one of %s", keyType, strings.Join(openSSHKeyTypes, ", "))
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return fmt.Errorf("key data is not valid base64")
+	}
+
+	// The key blob starts with the length-prefixed key type, which must
+	// match the type in the first field.
+	if len(blob) < 4 {
+		return fmt.Errorf("key data is truncated")
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(n) {
+		return fmt.Errorf("key data is truncated")
+	}
+	if embedded := string(blob[4 : 4+n]); embedded != keyType {
+		return fmt.Errorf("key data is of type %q but the key is labelled %q", embedded, keyType)
+	}
+	if len(blob) == int(4+n) {
+		return fmt.Errorf("key data is truncated")
+	}
+
+	return nil
+}
+
+func isOpenSSHKeyType(t string) bool {
+	for _, k := range openSSHKeyTypes {
+		if k == t {
+			return true
+		}
+	}
+	return false
+}